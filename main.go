@@ -43,6 +43,9 @@ func main() {
 	debuggingFlag := false
 	flaggy.Bool(&debuggingFlag, "d", "debug", "Run in debug mode with logging (see --logs flag below). Use the LOG_LEVEL env var to set the log level (debug/info/warn/error)")
 
+	readOnlyFlag := false
+	flaggy.Bool(&readOnlyFlag, "r", "readonly", "Run in read-only mode, where only browsing history, diffs and blame is allowed and anything that would change the repo is disabled")
+
 	logFlag := false
 	flaggy.Bool(&logFlag, "l", "logs", "Tail lazygit logs (intended to be used when `lazygit --debug` is called in a separate terminal tab)")
 
@@ -116,7 +119,7 @@ func main() {
 		}
 	}
 
-	appConfig, err := config.NewAppConfig("lazygit", version, commit, date, buildSource, debuggingFlag)
+	appConfig, err := config.NewAppConfig("lazygit", version, commit, date, buildSource, debuggingFlag, readOnlyFlag)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
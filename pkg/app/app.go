@@ -237,6 +237,10 @@ func (app *App) Run() error {
 		os.Exit(0)
 	}
 
+	if app.ClientContext == oscommands.ASKPASS_CLIENT_COMMAND {
+		return app.Askpass()
+	}
+
 	err := app.Gui.RunAndHandleError()
 	return err
 }
@@ -270,6 +274,17 @@ func (app *App) Rebase() error {
 	return nil
 }
 
+// Askpass is for when we've been run in demon mode as git or ssh's askpass
+// program, in which case the prompt is our only argument
+func (app *App) Askpass() error {
+	prompt := ""
+	if len(os.Args) > 1 {
+		prompt = os.Args[1]
+	}
+
+	return oscommands.RunAskpassClient(os.Getenv(oscommands.ASKPASS_SOCKET_ENV_VAR), prompt, os.Stdout)
+}
+
 // Close closes any resources
 func (app *App) Close() error {
 	for _, closer := range app.closers {
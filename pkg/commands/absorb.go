@@ -0,0 +1,175 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+)
+
+// Absorbing staged changes works a commit at a time: we find which commit each
+// staged hunk should be absorbed into, and create a fixup commit containing all
+// of the hunks for one of those commits. Then we start again, given that
+// committing those hunks changes the line numbers of the rest. Once every hunk
+// that can be absorbed has been, an autosquash rebase squashes the fixups in.
+
+// CreateAbsorbFixupCommits creates fixup commits for the staged hunks that can be
+// absorbed into the given commits, and returns the sha of the earliest commit
+// fixed up. That's empty if nothing could be absorbed. Hunks whose lines weren't
+// last touched by one of the given commits are left staged. Each fixup commit is
+// created by passing its command to runCommitCommand, so that the caller can
+// let gpg ask for a passphrase.
+func (c *GitCommand) CreateAbsorbFixupCommits(commits []*models.Commit, runCommitCommand func(cmdStr string) error) (string, error) {
+	candidates := absorbCandidates(commits)
+	oldestIdx := -1
+
+	for {
+		sha, patchStr, err := c.nextAbsorbPatch(candidates)
+		if err != nil {
+			return "", err
+		}
+		if sha == "" {
+			break
+		}
+
+		if err := c.createFixupCommitFromPatch(sha, patchStr, runCommitCommand); err != nil {
+			return "", err
+		}
+
+		if candidates[sha] > oldestIdx {
+			oldestIdx = candidates[sha]
+		}
+	}
+
+	if oldestIdx == -1 {
+		return "", nil
+	}
+
+	return commits[oldestIdx].Sha, nil
+}
+
+// absorbCandidates maps the sha of each commit we can absorb changes into to
+// its index. We stop at the first pushed or merged commit, because we don't
+// want to rewrite published history, at the first merge commit, because the
+// autosquash rebase would flatten it, and at the root commit, because there's
+// nothing to rebase onto.
+func absorbCandidates(commits []*models.Commit) map[string]int {
+	candidates := map[string]int{}
+	for i, commit := range commits {
+		if commit.Status == "pushed" || commit.Status == "merged" {
+			break
+		}
+		if commit.IsMerge() || len(commit.Parents) == 0 || commit.Parents[0] == "" {
+			break
+		}
+		candidates[commit.Sha] = i
+	}
+
+	return candidates
+}
+
+// nextAbsorbPatch returns the commit that the first absorbable staged hunk
+// belongs to, along with a patch of all the staged hunks that belong to it
+func (c *GitCommand) nextAbsorbPatch(candidates map[string]int) (string, string, error) {
+	// new, deleted, or renamed files have no lines in common with an earlier
+	// commit, so we only consider modified files
+	output, err := c.OSCommand.RunCommandWithOutput("git diff --cached --name-only -z --diff-filter=M")
+	if err != nil {
+		return "", "", err
+	}
+
+	targetSha := ""
+	var patchBuilder strings.Builder
+	for _, filename := range strings.Split(output, "\x00") {
+		if filename == "" {
+			continue
+		}
+
+		diff, err := c.OSCommand.RunCommandWithOutput("git diff --cached --no-ext-diff --no-color -U0 -- %s", c.OSCommand.Quote(filename))
+		if err != nil {
+			return "", "", err
+		}
+
+		blameLines, err := c.GetBlame(filename, "HEAD")
+		if err != nil {
+			return "", "", err
+		}
+
+		diffLines := strings.SplitAfter(diff, "\n")
+		fileHunks := []string{}
+		for _, hunk := range patch.GetHunksFromDiff(diff) {
+			sha := absorbTargetForHunk(hunk, blameLines, candidates)
+			if sha == "" || (targetSha != "" && sha != targetSha) {
+				continue
+			}
+			targetSha = sha
+			fileHunks = append(fileHunks, strings.Join(diffLines[hunk.FirstLineIdx:hunk.LastLineIdx()+1], ""))
+		}
+
+		if len(fileHunks) > 0 {
+			patchBuilder.WriteString(patch.GetHeaderFromDiff(diff))
+			patchBuilder.WriteString(strings.Join(fileHunks, ""))
+		}
+	}
+
+	return targetSha, patchBuilder.String(), nil
+}
+
+// absorbTargetForHunk returns the most recent of the candidate commits that last
+// touched the lines the hunk removes or, if it only adds lines, the lines either
+// side of them. Returns an empty string if there's no such commit.
+func absorbTargetForHunk(hunk *patch.PatchHunk, blameLines []*models.BlameLine, candidates map[string]int) string {
+	lineNumbers := hunk.RemovedLineNumbers()
+	if len(lineNumbers) == 0 {
+		lineNumbers = []int{hunk.OldStart(), hunk.OldStart() + 1}
+	}
+
+	result := ""
+	for _, lineNumber := range lineNumbers {
+		if lineNumber < 1 || lineNumber > len(blameLines) {
+			continue
+		}
+
+		sha := blameLines[lineNumber-1].Commit.Sha
+		idx, ok := candidates[sha]
+		if !ok {
+			continue
+		}
+		if result == "" || idx < candidates[result] {
+			result = sha
+		}
+	}
+
+	return result
+}
+
+// createFixupCommitFromPatch commits just the given patch as a fixup for the
+// given commit. We swap out the index while we do so, so that the rest of what's
+// staged stays staged.
+func (c *GitCommand) createFixupCommitFromPatch(sha string, patchStr string, runCommitCommand func(cmdStr string) error) error {
+	savedTree, err := c.OSCommand.RunCommandWithOutput("git write-tree")
+	if err != nil {
+		return err
+	}
+	savedTree = strings.TrimSpace(savedTree)
+
+	restoreIndex := func() error {
+		return c.RunCommand("git read-tree %s", savedTree)
+	}
+
+	if err := c.RunCommand("git read-tree HEAD"); err != nil {
+		return err
+	}
+
+	if err := c.ApplyPatch(patchStr, "cached", "unidiff-zero"); err != nil {
+		_ = restoreIndex()
+		return err
+	}
+
+	if err := runCommitCommand(c.CreateFixupCommitCmdStr(sha)); err != nil {
+		_ = restoreIndex()
+		return err
+	}
+
+	return restoreIndex()
+}
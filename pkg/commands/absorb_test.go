@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAbsorbCandidates is a function.
+func TestAbsorbCandidates(t *testing.T) {
+	type scenario struct {
+		testName string
+		commits  []*models.Commit
+		expected map[string]int
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "stops at merge commit",
+			commits: []*models.Commit{
+				{Sha: "a", Parents: []string{"b"}},
+				{Sha: "b", Parents: []string{"c", "d"}},
+				{Sha: "c", Parents: []string{"e"}},
+			},
+			expected: map[string]int{"a": 0},
+		},
+		{
+			testName: "stops at root commit",
+			commits: []*models.Commit{
+				{Sha: "a", Parents: []string{"b"}},
+				{Sha: "b", Parents: []string{"c"}},
+				{Sha: "c", Parents: []string{""}},
+			},
+			expected: map[string]int{"a": 0, "b": 1},
+		},
+		{
+			testName: "stops at pushed commit",
+			commits: []*models.Commit{
+				{Sha: "a", Parents: []string{"b"}, Status: "unpushed"},
+				{Sha: "b", Parents: []string{"c"}, Status: "pushed"},
+				{Sha: "c", Parents: []string{"d"}, Status: "pushed"},
+			},
+			expected: map[string]int{"a": 0},
+		},
+		{
+			testName: "stops at merged commit",
+			commits: []*models.Commit{
+				{Sha: "a", Parents: []string{"b"}, Status: "unpushed"},
+				{Sha: "b", Parents: []string{"c"}, Status: "unpushed"},
+				{Sha: "c", Parents: []string{"d"}, Status: "merged"},
+			},
+			expected: map[string]int{"a": 0, "b": 1},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.EqualValues(t, s.expected, absorbCandidates(s.commits))
+		})
+	}
+}
+
+// TestAbsorbTargetForHunk is a function.
+func TestAbsorbTargetForHunk(t *testing.T) {
+	blameLines := []*models.BlameLine{}
+	for _, sha := range []string{"old", "a", "b", "b", "c"} {
+		blameLines = append(blameLines, &models.BlameLine{Commit: &models.BlameCommit{Sha: sha}})
+	}
+	candidates := map[string]int{"a": 0, "b": 1, "c": 2}
+
+	type scenario struct {
+		testName string
+		hunk     string
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "removed lines from one commit",
+			hunk:     "@@ -3,2 +3 @@\n-three\n-four\n+3 and 4\n",
+			expected: "b",
+		},
+		{
+			testName: "removed lines from several commits picks the most recent",
+			hunk:     "@@ -2,2 +2 @@\n-two\n-three\n+2 and 3\n",
+			expected: "a",
+		},
+		{
+			testName: "added lines use the lines either side",
+			hunk:     "@@ -4,0 +5 @@\n+four and a half\n",
+			expected: "b",
+		},
+		{
+			testName: "lines from a commit we can't absorb into",
+			hunk:     "@@ -1 +1 @@\n-one\n+1\n",
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			hunk := patch.GetHunksFromDiff(s.hunk)[0]
+			assert.EqualValues(t, s.expected, absorbTargetForHunk(hunk, blameLines, candidates))
+		})
+	}
+}
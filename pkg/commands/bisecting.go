@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// GetBisectInfo reads the state of the current bisect, if any, from the .git
+// directory. If we're not bisecting, the returned info's Bisecting() is false.
+func (c *GitCommand) GetBisectInfo() *models.BisectInfo {
+	info := models.NewBisectInfo()
+
+	start, err := ioutil.ReadFile(filepath.Join(c.DotGitDir, "BISECT_START"))
+	if err != nil {
+		// no bisect in progress
+		return info
+	}
+	info.Start = strings.TrimSpace(string(start))
+
+	// BISECT_TERMS only exists if the user has specified custom terms
+	if terms, err := ioutil.ReadFile(filepath.Join(c.DotGitDir, "BISECT_TERMS")); err == nil {
+		lines := strings.Split(strings.TrimSpace(string(terms)), "\n")
+		if len(lines) == 2 {
+			info.NewTerm = strings.TrimSpace(lines[0])
+			info.OldTerm = strings.TrimSpace(lines[1])
+		}
+	}
+
+	cmdStr := `git for-each-ref --format="%(refname) %(objectname)" refs/bisect`
+	output, err := c.RunCommandWithOutput(cmdStr)
+	if err != nil {
+		c.Log.Error(err)
+		return info
+	}
+	parseBisectRefs(info, output)
+
+	current, err := c.RunCommandWithOutput("git rev-parse HEAD")
+	if err == nil {
+		info.Current = strings.TrimSpace(current)
+	}
+
+	return info
+}
+
+// parseBisectRefs sets the status of each commit referenced by a refs/bisect
+// ref. Git names these refs 'bad', 'good-<sha>' and 'skip-<sha>', where 'bad'
+// and 'good' may be swapped out for custom terms
+func parseBisectRefs(info *models.BisectInfo, output string) {
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+
+		name := strings.TrimPrefix(fields[0], "refs/bisect/")
+		sha := fields[1]
+
+		switch {
+		case name == info.NewTerm:
+			info.SetStatus(sha, models.BisectStatusBad)
+		case strings.HasPrefix(name, info.OldTerm+"-"):
+			info.SetStatus(sha, models.BisectStatusGood)
+		case strings.HasPrefix(name, "skip-"):
+			info.SetStatus(sha, models.BisectStatusSkipped)
+		}
+	}
+}
+
+func (c *GitCommand) IsInBisectState() (bool, error) {
+	return c.OSCommand.FileExists(filepath.Join(c.DotGitDir, "BISECT_START"))
+}
+
+func (c *GitCommand) BisectStart() error {
+	return c.RunCommand("git bisect start")
+}
+
+// BisectMark marks the given ref with a term like 'good', 'bad', or 'skip'
+// (or one of the user's custom terms)
+func (c *GitCommand) BisectMark(ref string, term string) error {
+	return c.RunCommand("git bisect %s %s", term, ref)
+}
+
+// BisectReset ends the bisect, returning to the ref we started from
+func (c *GitCommand) BisectReset() error {
+	return c.RunCommand("git bisect reset")
+}
+
+// IsBisectDone tells us whether the first bad commit has been found, by
+// checking whether every commit still in the range between the good commits
+// and the bad commit has been either marked bad or skipped. It also returns
+// the remaining candidates: if some commits were skipped git can't tell which
+// of them introduced the change, in which case there'll be more than one.
+func (c *GitCommand) IsBisectDone(info *models.BisectInfo) (bool, []string, error) {
+	badSha := info.BadSha()
+	goodShas := info.ShasWithStatus(models.BisectStatusGood)
+	if badSha == "" || len(goodShas) == 0 {
+		return false, nil, nil
+	}
+
+	output, err := c.RunCommandWithOutput("git rev-list %s --not %s", badSha, strings.Join(goodShas, " "))
+	if err != nil {
+		return false, nil, err
+	}
+
+	candidates := utils.SplitLines(output)
+	for _, sha := range candidates {
+		status := info.Status(sha)
+		if status != models.BisectStatusBad && status != models.BisectStatusSkipped {
+			return false, candidates, nil
+		}
+	}
+
+	return true, candidates, nil
+}
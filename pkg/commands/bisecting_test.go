@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/secureexec"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseBisectRefs is a function.
+func TestParseBisectRefs(t *testing.T) {
+	type scenario struct {
+		testName string
+		newTerm  string
+		oldTerm  string
+		output   string
+		expected map[string]models.BisectStatus
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "No refs",
+			newTerm:  "bad",
+			oldTerm:  "good",
+			output:   "",
+			expected: map[string]models.BisectStatus{},
+		},
+		{
+			testName: "Default terms",
+			newTerm:  "bad",
+			oldTerm:  "good",
+			output: `refs/bisect/bad aaa
+refs/bisect/good-bbb bbb
+refs/bisect/good-ccc ccc
+refs/bisect/skip-ddd ddd
+`,
+			expected: map[string]models.BisectStatus{
+				"aaa": models.BisectStatusBad,
+				"bbb": models.BisectStatusGood,
+				"ccc": models.BisectStatusGood,
+				"ddd": models.BisectStatusSkipped,
+			},
+		},
+		{
+			testName: "Custom terms",
+			newTerm:  "fixed",
+			oldTerm:  "broken",
+			output: `refs/bisect/fixed aaa
+refs/bisect/broken-bbb bbb
+refs/bisect/skip-ccc ccc
+`,
+			expected: map[string]models.BisectStatus{
+				"aaa": models.BisectStatusBad,
+				"bbb": models.BisectStatusGood,
+				"ccc": models.BisectStatusSkipped,
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			info := models.NewBisectInfo()
+			info.NewTerm = s.newTerm
+			info.OldTerm = s.oldTerm
+			parseBisectRefs(info, s.output)
+
+			for sha, status := range s.expected {
+				assert.EqualValues(t, status, info.Status(sha))
+			}
+			assert.EqualValues(t, "", info.Current)
+		})
+	}
+}
+
+// TestGitCommandBisectMark is a function.
+func TestGitCommandBisectMark(t *testing.T) {
+	gitCmd := NewDummyGitCommand()
+	gitCmd.OSCommand.Command = func(cmd string, args ...string) *exec.Cmd {
+		assert.EqualValues(t, "git", cmd)
+		assert.EqualValues(t, []string{"bisect", "skip", "abc123"}, args)
+
+		return secureexec.Command("echo")
+	}
+
+	assert.NoError(t, gitCmd.BisectMark("abc123", "skip"))
+}
+
+// TestGitCommandIsBisectDone is a function.
+func TestGitCommandIsBisectDone(t *testing.T) {
+	type scenario struct {
+		testName           string
+		statuses           map[string]models.BisectStatus
+		revListOutput      string
+		expectedDone       bool
+		expectedCandidates []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "No good commits yet",
+			statuses: map[string]models.BisectStatus{
+				"aaa": models.BisectStatusBad,
+			},
+			expectedDone:       false,
+			expectedCandidates: nil,
+		},
+		{
+			testName: "Untested commits remain",
+			statuses: map[string]models.BisectStatus{
+				"aaa": models.BisectStatusBad,
+				"ddd": models.BisectStatusGood,
+			},
+			revListOutput:      "aaa\nbbb\nccc\n",
+			expectedDone:       false,
+			expectedCandidates: []string{"aaa", "bbb", "ccc"},
+		},
+		{
+			testName: "First bad commit found",
+			statuses: map[string]models.BisectStatus{
+				"aaa": models.BisectStatusBad,
+				"bbb": models.BisectStatusGood,
+			},
+			revListOutput:      "aaa\n",
+			expectedDone:       true,
+			expectedCandidates: []string{"aaa"},
+		},
+		{
+			testName: "Only skipped commits remain",
+			statuses: map[string]models.BisectStatus{
+				"aaa": models.BisectStatusBad,
+				"bbb": models.BisectStatusSkipped,
+				"ccc": models.BisectStatusGood,
+			},
+			revListOutput:      "aaa\nbbb\n",
+			expectedDone:       true,
+			expectedCandidates: []string{"aaa", "bbb"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			gitCmd.OSCommand.Command = func(cmd string, args ...string) *exec.Cmd {
+				assert.EqualValues(t, "git", cmd)
+				assert.EqualValues(t, "rev-list", args[0])
+
+				return secureexec.Command("printf", s.revListOutput)
+			}
+
+			info := models.NewBisectInfo()
+			for sha, status := range s.statuses {
+				info.SetStatus(sha, status)
+			}
+
+			done, candidates, err := gitCmd.IsBisectDone(info)
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expectedDone, done)
+			assert.EqualValues(t, s.expectedCandidates, candidates)
+		})
+	}
+}
@@ -33,6 +33,12 @@ func (c *GitCommand) CommitCmdStr(message string, flags string) string {
 	return fmt.Sprintf("git commit%s%s", flagsStr, lineArgs)
 }
 
+// GetHeadSha returns the sha of the commit HEAD points to
+func (c *GitCommand) GetHeadSha() (string, error) {
+	sha, err := c.OSCommand.RunCommandWithOutput("git rev-parse HEAD")
+	return strings.TrimSpace(sha), err
+}
+
 // Get the subject of the HEAD commit
 func (c *GitCommand) GetHeadCommitMessage() (string, error) {
 	cmdStr := "git log -1 --pretty=%s"
@@ -65,7 +71,11 @@ func (c *GitCommand) ShowCmdStr(sha string, filterPath string) string {
 	if filterPath != "" {
 		filterPathArg = fmt.Sprintf(" -- %s", c.OSCommand.Quote(filterPath))
 	}
-	return fmt.Sprintf("git show --submodule --color=%s --no-renames --stat -p %s %s", c.colorArg(), sha, filterPathArg)
+	signatureArg := ""
+	if c.Config.GetUserConfig().Git.Log.ShowSignature {
+		signatureArg = " --show-signature"
+	}
+	return fmt.Sprintf("git show --submodule --color=%s --no-renames --stat -p%s %s %s", c.colorArg(), signatureArg, sha, filterPathArg)
 }
 
 // Revert reverts the selected commit by sha
@@ -79,10 +89,13 @@ func (c *GitCommand) RevertMerge(sha string, parentNumber int) error {
 
 // CherryPickCommits begins an interactive rebase with the given shas being cherry picked onto HEAD
 func (c *GitCommand) CherryPickCommits(commits []*models.Commit) error {
-	todo := ""
-	for _, commit := range commits {
-		todo = "pick " + commit.Sha + " " + commit.Name + "\n" + todo
+	actions := make([]string, len(commits))
+	for i := range actions {
+		actions[i] = "pick"
 	}
+	// the picked commits are copies, so the branches whose heads are at the
+	// originals stay where they are
+	todo := rebaseTodo(commits, actions, nil)
 
 	cmd, err := c.PrepareInteractiveRebaseCommand("HEAD", todo, false)
 	if err != nil {
@@ -94,5 +107,9 @@ func (c *GitCommand) CherryPickCommits(commits []*models.Commit) error {
 
 // CreateFixupCommit creates a commit that fixes up a previous commit
 func (c *GitCommand) CreateFixupCommit(sha string) error {
-	return c.RunCommand("git commit --fixup=%s", sha)
+	return c.RunCommand(c.CreateFixupCommitCmdStr(sha))
+}
+
+func (c *GitCommand) CreateFixupCommitCmdStr(sha string) string {
+	return fmt.Sprintf("git commit --fixup=%s", sha)
 }
@@ -55,8 +55,22 @@ func (c *GitCommand) UsingGpg() bool {
 		return false
 	}
 
-	gpgsign := c.GetConfigValue("commit.gpgsign")
-	value := strings.ToLower(gpgsign)
+	return c.isConfigValueTrue("commit.gpgsign")
+}
+
+// UsingGpgForTag is like UsingGpg but for creating an annotated tag. Tags are
+// signed either when explicitly asked for or when tag.gpgSign is set
+func (c *GitCommand) UsingGpgForTag(sign bool) bool {
+	overrideGpg := c.Config.GetUserConfig().Git.OverrideGpg
+	if overrideGpg {
+		return false
+	}
+
+	return sign || c.isConfigValueTrue("tag.gpgSign")
+}
+
+func (c *GitCommand) isConfigValueTrue(key string) bool {
+	value := strings.ToLower(c.GetConfigValue(key))
 
 	return value == "true" || value == "1" || value == "yes" || value == "on"
 }
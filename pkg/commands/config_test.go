@@ -68,3 +68,45 @@ func TestGitCommandUsingGpg(t *testing.T) {
 		})
 	}
 }
+
+// TestGitCommandUsingGpgForTag is a function.
+func TestGitCommandUsingGpgForTag(t *testing.T) {
+	type scenario struct {
+		testName          string
+		sign              bool
+		getGitConfigValue func(string) (string, error)
+		expected          bool
+	}
+
+	scenarios := []scenario{
+		{
+			"Unsigned tag without tag.gpgSign",
+			false,
+			func(string) (string, error) { return "", nil },
+			false,
+		},
+		{
+			"Signed tag",
+			true,
+			func(string) (string, error) { return "", nil },
+			true,
+		},
+		{
+			"Unsigned tag with tag.gpgSign",
+			false,
+			func(key string) (string, error) {
+				assert.EqualValues(t, "tag.gpgSign", key)
+				return "true", nil
+			},
+			true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			gitCmd.getGitConfigValue = s.getGitConfigValue
+			assert.EqualValues(t, s.expected, gitCmd.UsingGpgForTag(s.sign))
+		})
+	}
+}
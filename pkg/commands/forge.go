@@ -0,0 +1,322 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// A ForgeClient talks to the REST API of the service a repo is hosted on, so
+// that we can show the status of each branch's pull request. We only look at
+// the first page of open pull requests, which is plenty for the branches that
+// someone has checked out locally. Listing them takes a single request, and we
+// then make one more for the reviews of each pull request whose branch we have
+// locally, so that a busy repo doesn't use up the API's rate limit.
+type ForgeClient interface {
+	// GetOpenPullRequests returns the repo's open pull requests for the given
+	// branches, keyed by the name of their source branch. Pull requests from
+	// forks are left out because their branch names have nothing to do with ours.
+	GetOpenPullRequests(owner string, repo string, branchNames []string) (map[string]*models.PullRequest, error)
+}
+
+// ForgeRateLimitError is returned when the API refuses a request with a 403 or
+// 429 status, which is how GitHub, GitLab and Gitea say that we've made too many
+// requests. We shouldn't ask again until the given time.
+type ForgeRateLimitError struct {
+	Message    string
+	RetryAfter time.Time
+}
+
+func (e *ForgeRateLimitError) Error() string {
+	return e.Message
+}
+
+// how long to back off for when the API doesn't say
+const defaultForgeBackoff = 15 * time.Minute
+
+// NewForgeClient returns a client for the API of the given service type, or nil
+// if we don't support that service's API. The token may be empty, in which case
+// requests are made anonymously.
+func NewForgeClient(typeName string, apiURL string, token string, httpClient *http.Client) ForgeClient {
+	api := &forgeAPI{baseURL: strings.TrimSuffix(apiURL, "/"), token: token, httpClient: httpClient}
+
+	switch typeName {
+	case "github":
+		api.authHeader, api.authPrefix = "Authorization", "token "
+		return &githubClient{api: api}
+	case "gitlab":
+		api.authHeader = "PRIVATE-TOKEN"
+		return &gitlabClient{api: api}
+	case "gitea", "forgejo":
+		api.authHeader, api.authPrefix = "Authorization", "token "
+		return &giteaClient{api: api}
+	}
+
+	return nil
+}
+
+// getForgeAPIURL returns the root of the REST API for a service type hosted on
+// the given domain, or an empty string if we don't support that service's API
+func getForgeAPIURL(typeName string, siteDomain string) string {
+	switch typeName {
+	case "github":
+		if siteDomain == "github.com" {
+			return "https://api.github.com"
+		}
+		// GitHub Enterprise
+		return fmt.Sprintf("https://%s/api/v3", siteDomain)
+	case "gitlab":
+		return fmt.Sprintf("https://%s/api/v4", siteDomain)
+	case "gitea", "forgejo":
+		return fmt.Sprintf("https://%s/api/v1", siteDomain)
+	}
+
+	return ""
+}
+
+type forgeAPI struct {
+	baseURL    string
+	token      string
+	authHeader string
+	authPrefix string
+	httpClient *http.Client
+}
+
+// getJSON makes a GET request to the given path under the API root and decodes
+// the JSON response into result
+func (api *forgeAPI) getJSON(path string, result interface{}) error {
+	req, err := http.NewRequest("GET", api.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if api.token != "" {
+		req.Header.Set(api.authHeader, api.authPrefix+api.token)
+	}
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		return &ForgeRateLimitError{
+			Message:    fmt.Sprintf("GET %s returned %s", api.baseURL+path, resp.Status),
+			RetryAfter: getRetryAfter(resp.Header, time.Now()),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("GET %s returned %s", api.baseURL+path, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// getRetryAfter works out when we can make requests again from the response's
+// Retry-After header (in seconds) or, failing that, its rate limit reset header
+// (a unix timestamp), which is X-RateLimit-Reset on GitHub and Gitea and
+// RateLimit-Reset on GitLab
+func getRetryAfter(header http.Header, now time.Time) time.Time {
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil {
+		return now.Add(time.Duration(seconds) * time.Second)
+	}
+
+	for _, key := range []string{"X-RateLimit-Reset", "RateLimit-Reset"} {
+		if timestamp, err := strconv.ParseInt(header.Get(key), 10, 64); err == nil {
+			if reset := time.Unix(timestamp, 0); reset.After(now) {
+				return reset
+			}
+		}
+	}
+
+	return now.Add(defaultForgeBackoff)
+}
+
+type forgeReview struct {
+	State string `json:"state"`
+	User  struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+// getReviewStatus boils a pull request's reviews down to a single status, going
+// by each reviewer's latest verdict: any request for changes trumps approvals.
+// Reviews are expected oldest first, and comments don't count as a verdict.
+func getReviewStatus(reviews []forgeReview, approvedState string, changesRequestedState string) string {
+	latestByUser := map[string]string{}
+	for _, review := range reviews {
+		if review.State == approvedState || review.State == changesRequestedState {
+			latestByUser[review.User.Login] = review.State
+		}
+	}
+
+	result := ""
+	for _, state := range latestByUser {
+		if state == changesRequestedState {
+			return models.REVIEW_CHANGES_REQUESTED
+		}
+		result = models.REVIEW_APPROVED
+	}
+
+	return result
+}
+
+// the pull request fields that GitHub and Gitea have in common
+type forgePullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Draft   bool   `json:"draft"`
+	Head    struct {
+		Ref  string `json:"ref"`
+		Repo *struct {
+			FullName string `json:"full_name"`
+		} `json:"repo"`
+	} `json:"head"`
+}
+
+func (pr *forgePullRequest) isFromRepo(owner string, repo string) bool {
+	return pr.Head.Repo != nil && strings.EqualFold(pr.Head.Repo.FullName, owner+"/"+repo)
+}
+
+type githubClient struct {
+	api *forgeAPI
+}
+
+func (c *githubClient) GetOpenPullRequests(owner string, repo string, branchNames []string) (map[string]*models.PullRequest, error) {
+	repoPath := fmt.Sprintf("/repos/%s/%s", owner, repo)
+	return getForgePullRequests(
+		c.api,
+		owner,
+		repo,
+		branchNames,
+		repoPath+"/pulls?state=open&per_page=100",
+		repoPath+"/pulls/%d/reviews?per_page=100",
+		"APPROVED",
+		"CHANGES_REQUESTED",
+	)
+}
+
+type giteaClient struct {
+	api *forgeAPI
+}
+
+func (c *giteaClient) GetOpenPullRequests(owner string, repo string, branchNames []string) (map[string]*models.PullRequest, error) {
+	repoPath := fmt.Sprintf("/repos/%s/%s", owner, repo)
+	return getForgePullRequests(
+		c.api,
+		owner,
+		repo,
+		branchNames,
+		repoPath+"/pulls?state=open&limit=50",
+		repoPath+"/pulls/%d/reviews",
+		"APPROVED",
+		"REQUEST_CHANGES",
+	)
+}
+
+// getForgePullRequests does the work for GitHub and Gitea, whose APIs only
+// differ in the details
+func getForgePullRequests(api *forgeAPI, owner string, repo string, branchNames []string, pullsPath string, reviewsPathFormat string, approvedState string, changesRequestedState string) (map[string]*models.PullRequest, error) {
+	var pulls []forgePullRequest
+	if err := api.getJSON(pullsPath, &pulls); err != nil {
+		return nil, err
+	}
+
+	result := map[string]*models.PullRequest{}
+	for _, pull := range pulls {
+		if !pull.isFromRepo(owner, repo) || !utils.IncludesString(branchNames, pull.Head.Ref) {
+			continue
+		}
+
+		var reviews []forgeReview
+		if err := api.getJSON(fmt.Sprintf(reviewsPathFormat, pull.Number), &reviews); err != nil {
+			return nil, err
+		}
+
+		state := models.PULL_REQUEST_OPEN
+		if pull.Draft {
+			state = models.PULL_REQUEST_DRAFT
+		}
+
+		result[pull.Head.Ref] = &models.PullRequest{
+			Number:       pull.Number,
+			State:        state,
+			ReviewStatus: getReviewStatus(reviews, approvedState, changesRequestedState),
+			URL:          pull.HTMLURL,
+		}
+	}
+
+	return result, nil
+}
+
+type gitlabClient struct {
+	api *forgeAPI
+}
+
+type gitlabMergeRequest struct {
+	IID             int    `json:"iid"`
+	WebURL          string `json:"web_url"`
+	Draft           bool   `json:"draft"`
+	WorkInProgress  bool   `json:"work_in_progress"`
+	SourceBranch    string `json:"source_branch"`
+	ProjectID       int    `json:"project_id"`
+	SourceProjectID int    `json:"source_project_id"`
+}
+
+type gitlabApprovals struct {
+	Approved   bool          `json:"approved"`
+	ApprovedBy []interface{} `json:"approved_by"`
+}
+
+func (c *gitlabClient) GetOpenPullRequests(owner string, repo string, branchNames []string) (map[string]*models.PullRequest, error) {
+	projectPath := "/projects/" + url.PathEscape(owner+"/"+repo)
+
+	var mergeRequests []gitlabMergeRequest
+	if err := c.api.getJSON(projectPath+"/merge_requests?state=opened&per_page=100", &mergeRequests); err != nil {
+		return nil, err
+	}
+
+	result := map[string]*models.PullRequest{}
+	for _, mergeRequest := range mergeRequests {
+		if mergeRequest.SourceProjectID != mergeRequest.ProjectID || !utils.IncludesString(branchNames, mergeRequest.SourceBranch) {
+			continue
+		}
+
+		var approvals gitlabApprovals
+		if err := c.api.getJSON(fmt.Sprintf("%s/merge_requests/%d/approvals", projectPath, mergeRequest.IID), &approvals); err != nil {
+			return nil, err
+		}
+
+		state := models.PULL_REQUEST_OPEN
+		if mergeRequest.Draft || mergeRequest.WorkInProgress {
+			state = models.PULL_REQUEST_DRAFT
+		}
+
+		// GitLab has no notion of requesting changes, and it considers a merge
+		// request with no required approvals to be approved, so we also check
+		// that somebody actually approved it
+		reviewStatus := ""
+		if approvals.Approved && len(approvals.ApprovedBy) > 0 {
+			reviewStatus = models.REVIEW_APPROVED
+		}
+
+		result[mergeRequest.SourceBranch] = &models.PullRequest{
+			Number:       mergeRequest.IID,
+			State:        state,
+			ReviewStatus: reviewStatus,
+			URL:          mergeRequest.WebURL,
+		}
+	}
+
+	return result, nil
+}
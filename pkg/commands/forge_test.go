@@ -0,0 +1,234 @@
+package commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/secureexec"
+	"github.com/stretchr/testify/assert"
+)
+
+// newForgeServer stands in for a hosting service's API, responding to each
+// request URI with the given JSON, and checking that the auth header is set
+func newForgeServer(t *testing.T, authHeader string, authValue string, responses map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, authValue, r.Header.Get(authHeader))
+
+		response, ok := responses[r.RequestURI]
+		if !ok {
+			t.Errorf("unexpected request: %s", r.RequestURI)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+// TestForgeClientGetOpenPullRequests is a function.
+func TestForgeClientGetOpenPullRequests(t *testing.T) {
+	type scenario struct {
+		testName   string
+		typeName   string
+		authHeader string
+		authValue  string
+		responses  map[string]string
+		expected   map[string]*models.PullRequest
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "GitHub",
+			typeName:   "github",
+			authHeader: "Authorization",
+			authValue:  "token secret",
+			responses: map[string]string{
+				"/repos/owner/repo/pulls?state=open&per_page=100": `[
+					{"number": 1, "html_url": "https://github.com/owner/repo/pull/1", "draft": false, "head": {"ref": "feature", "repo": {"full_name": "owner/repo"}}},
+					{"number": 2, "html_url": "https://github.com/owner/repo/pull/2", "draft": true, "head": {"ref": "wip", "repo": {"full_name": "Owner/Repo"}}},
+					{"number": 3, "html_url": "https://github.com/owner/repo/pull/3", "draft": false, "head": {"ref": "master", "repo": {"full_name": "someone/repo"}}},
+					{"number": 10, "html_url": "https://github.com/owner/repo/pull/10", "draft": false, "head": {"ref": "not-local", "repo": {"full_name": "owner/repo"}}}
+				]`,
+				"/repos/owner/repo/pulls/1/reviews?per_page=100": `[
+					{"state": "CHANGES_REQUESTED", "user": {"login": "alice"}},
+					{"state": "COMMENTED", "user": {"login": "alice"}},
+					{"state": "APPROVED", "user": {"login": "alice"}},
+					{"state": "APPROVED", "user": {"login": "bob"}}
+				]`,
+				"/repos/owner/repo/pulls/2/reviews?per_page=100": `[
+					{"state": "APPROVED", "user": {"login": "alice"}},
+					{"state": "CHANGES_REQUESTED", "user": {"login": "bob"}}
+				]`,
+			},
+			expected: map[string]*models.PullRequest{
+				"feature": {Number: 1, State: "open", ReviewStatus: "approved", URL: "https://github.com/owner/repo/pull/1"},
+				"wip":     {Number: 2, State: "draft", ReviewStatus: "changes requested", URL: "https://github.com/owner/repo/pull/2"},
+			},
+		},
+		{
+			testName:   "GitLab",
+			typeName:   "gitlab",
+			authHeader: "PRIVATE-TOKEN",
+			authValue:  "secret",
+			responses: map[string]string{
+				"/projects/owner%2Frepo/merge_requests?state=opened&per_page=100": `[
+					{"iid": 4, "web_url": "https://gitlab.com/owner/repo/-/merge_requests/4", "draft": false, "source_branch": "feature", "project_id": 7, "source_project_id": 7},
+					{"iid": 5, "web_url": "https://gitlab.com/owner/repo/-/merge_requests/5", "work_in_progress": true, "source_branch": "wip", "project_id": 7, "source_project_id": 7},
+					{"iid": 6, "web_url": "https://gitlab.com/owner/repo/-/merge_requests/6", "draft": false, "source_branch": "master", "project_id": 7, "source_project_id": 8},
+					{"iid": 11, "web_url": "https://gitlab.com/owner/repo/-/merge_requests/11", "draft": false, "source_branch": "not-local", "project_id": 7, "source_project_id": 7}
+				]`,
+				"/projects/owner%2Frepo/merge_requests/4/approvals": `{"approved": true, "approved_by": [{"user": {"username": "alice"}}]}`,
+				"/projects/owner%2Frepo/merge_requests/5/approvals": `{"approved": true, "approved_by": []}`,
+			},
+			expected: map[string]*models.PullRequest{
+				"feature": {Number: 4, State: "open", ReviewStatus: "approved", URL: "https://gitlab.com/owner/repo/-/merge_requests/4"},
+				"wip":     {Number: 5, State: "draft", ReviewStatus: "", URL: "https://gitlab.com/owner/repo/-/merge_requests/5"},
+			},
+		},
+		{
+			testName:   "Gitea",
+			typeName:   "gitea",
+			authHeader: "Authorization",
+			authValue:  "token secret",
+			responses: map[string]string{
+				"/repos/owner/repo/pulls?state=open&limit=50": `[
+					{"number": 8, "html_url": "https://gitea.com/owner/repo/pulls/8", "head": {"ref": "feature", "repo": {"full_name": "owner/repo"}}},
+					{"number": 9, "html_url": "https://gitea.com/owner/repo/pulls/9", "head": {"ref": "deleted-fork", "repo": null}},
+					{"number": 12, "html_url": "https://gitea.com/owner/repo/pulls/12", "head": {"ref": "not-local", "repo": {"full_name": "owner/repo"}}}
+				]`,
+				"/repos/owner/repo/pulls/8/reviews": `[
+					{"state": "APPROVED", "user": {"login": "alice"}},
+					{"state": "REQUEST_CHANGES", "user": {"login": "bob"}}
+				]`,
+			},
+			expected: map[string]*models.PullRequest{
+				"feature": {Number: 8, State: "open", ReviewStatus: "changes requested", URL: "https://gitea.com/owner/repo/pulls/8"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			server := newForgeServer(t, s.authHeader, s.authValue, s.responses)
+			defer server.Close()
+
+			client := NewForgeClient(s.typeName, server.URL, "secret", server.Client())
+			pullRequests, err := client.GetOpenPullRequests("owner", "repo", []string{"feature", "wip", "master", "deleted-fork"})
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expected, pullRequests)
+		})
+	}
+}
+
+// TestForgeClientErrorResponse is a function.
+func TestForgeClientErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewForgeClient("github", server.URL, "", server.Client())
+	_, err := client.GetOpenPullRequests("owner", "repo", []string{"feature"})
+	assert.EqualError(t, err, "GET "+server.URL+"/repos/owner/repo/pulls?state=open&per_page=100 returned 401 Unauthorized")
+}
+
+// TestForgeClientRateLimitResponse is a function.
+func TestForgeClientRateLimitResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewForgeClient("gitlab", server.URL, "", server.Client())
+	_, err := client.GetOpenPullRequests("owner", "repo", []string{"feature"})
+	assert.EqualError(t, err, "GET "+server.URL+"/projects/owner%2Frepo/merge_requests?state=opened&per_page=100 returned 429 Too Many Requests")
+
+	rateLimitErr, ok := err.(*ForgeRateLimitError)
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), rateLimitErr.RetryAfter, 10*time.Second)
+}
+
+// TestGetRetryAfter is a function.
+func TestGetRetryAfter(t *testing.T) {
+	type scenario struct {
+		testName string
+		header   http.Header
+		expected time.Time
+	}
+
+	now := time.Unix(1600000000, 0)
+
+	scenarios := []scenario{
+		{
+			"Retry-After header",
+			http.Header{"Retry-After": []string{"60"}, "X-Ratelimit-Reset": []string{"1600003600"}},
+			now.Add(time.Minute),
+		},
+		{
+			"GitHub rate limit reset",
+			http.Header{"X-Ratelimit-Reset": []string{"1600003600"}},
+			time.Unix(1600003600, 0),
+		},
+		{
+			"GitLab rate limit reset",
+			http.Header{"Ratelimit-Reset": []string{"1600000600"}},
+			time.Unix(1600000600, 0),
+		},
+		{
+			"reset in the past",
+			http.Header{"X-Ratelimit-Reset": []string{"1500000000"}},
+			now.Add(defaultForgeBackoff),
+		},
+		{
+			"no headers",
+			http.Header{},
+			now.Add(defaultForgeBackoff),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, getRetryAfter(s.header, now))
+		})
+	}
+}
+
+// TestGetOpenPullRequests is a function.
+func TestGetOpenPullRequests(t *testing.T) {
+	server := newForgeServer(t, "Authorization", "token secret", map[string]string{
+		"/repos/owner/repo/pulls?state=open&limit=50": `[
+			{"number": 8, "html_url": "https://code.work.com/owner/repo/pulls/8", "head": {"ref": "feature", "repo": {"full_name": "owner/repo"}}}
+		]`,
+		"/repos/owner/repo/pulls/8/reviews": `[]`,
+	})
+	defer server.Close()
+
+	gitCommand := NewDummyGitCommand()
+	gitCommand.OSCommand.Command = func(cmd string, args ...string) *exec.Cmd {
+		assert.Equal(t, "bash", cmd)
+		assert.Equal(t, []string{"-c", "pass show work-token"}, args)
+		return secureexec.Command("echo", "secret")
+	}
+	gitCommand.Config.GetUserConfig().Git.PullRequestStatus.TokenCommands = map[string]string{
+		"git.work.com": "pass show work-token",
+	}
+	gitCommand.getGitConfigValue = func(path string) (string, error) {
+		assert.Equal(t, path, "remote.origin.url")
+		return "git@git.work.com:owner/repo.git", nil
+	}
+
+	pullRequest := NewPullRequest(gitCommand)
+	pullRequest.GitServices = []*Service{{Name: "git.work.com", Type: "gitea", APIURL: server.URL}}
+	pullRequest.HTTPClient = server.Client()
+
+	pullRequests, err := pullRequest.GetOpenPullRequests([]string{"feature"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, map[string]*models.PullRequest{
+		"feature": {Number: 8, State: "open", URL: "https://code.work.com/owner/repo/pulls/8"},
+	}, pullRequests)
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// BlameCmdStr returns the command for blaming a file. If ref is empty we blame
+// the file as it is in the working tree
+func (c *GitCommand) BlameCmdStr(filename string, ref string) string {
+	refArg := ""
+	if ref != "" {
+		refArg = " " + ref
+	}
+
+	return fmt.Sprintf("git blame --porcelain%s -- %s", refArg, c.OSCommand.Quote(filename))
+}
+
+func (c *GitCommand) GetBlame(filename string, ref string) ([]*models.BlameLine, error) {
+	output, err := c.OSCommand.RunCommandWithOutput(c.BlameCmdStr(filename, ref))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlame(output), nil
+}
+
+// parseBlame parses the output of `git blame --porcelain`. Each line of the
+// file is preceded by a header of the form '<sha> <orig line> <final line>'.
+// The first time a commit appears, the header is followed by the commit's
+// details e.g. 'author <name>'. Finally comes the line's content, prefixed
+// with a tab.
+func parseBlame(output string) []*models.BlameLine {
+	lines := []*models.BlameLine{}
+	commits := map[string]*models.BlameCommit{}
+	// the filename is only given when it differs from the last one given for
+	// the commit, so we need to remember it
+	filenames := map[string]string{}
+
+	var current *models.BlameLine
+	for _, outputLine := range utils.SplitLines(output) {
+		if strings.HasPrefix(outputLine, "\t") {
+			if current != nil {
+				current.Content = outputLine[1:]
+				current.Filename = filenames[current.Commit.Sha]
+				lines = append(lines, current)
+				current = nil
+			}
+			continue
+		}
+
+		if current == nil {
+			fields := strings.Fields(outputLine)
+			if len(fields) < 3 {
+				continue
+			}
+
+			sha := fields[0]
+			commit, ok := commits[sha]
+			if !ok {
+				commit = &models.BlameCommit{Sha: sha}
+				commits[sha] = commit
+			}
+
+			originalLineNumber, _ := strconv.Atoi(fields[1])
+			lineNumber, _ := strconv.Atoi(fields[2])
+
+			current = &models.BlameLine{
+				Commit:             commit,
+				OriginalLineNumber: originalLineNumber,
+				LineNumber:         lineNumber,
+			}
+			continue
+		}
+
+		key, value := splitBlameHeaderLine(outputLine)
+		commit := current.Commit
+		switch key {
+		case "author":
+			commit.Author = value
+		case "author-time":
+			commit.UnixTimestamp, _ = strconv.ParseInt(value, 10, 64)
+		case "summary":
+			commit.Summary = value
+		case "previous":
+			// e.g. 'previous <sha> <filename>'. The filename may contain spaces
+			split := strings.SplitN(value, " ", 2)
+			if len(split) == 2 {
+				commit.PreviousSha = split[0]
+				commit.PreviousFilename = split[1]
+			}
+		case "filename":
+			filenames[commit.Sha] = value
+		}
+	}
+
+	return lines
+}
+
+func splitBlameHeaderLine(line string) (string, string) {
+	split := strings.SplitN(line, " ", 2)
+	if len(split) == 1 {
+		return split[0], ""
+	}
+
+	return split[0], split[1]
+}
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitCommandBlameCmdStr is a function.
+func TestGitCommandBlameCmdStr(t *testing.T) {
+	type scenario struct {
+		testName string
+		filename string
+		ref      string
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Working tree",
+			filename: "file.txt",
+			ref:      "",
+			expected: `git blame --porcelain -- "file.txt"`,
+		},
+		{
+			testName: "At a commit",
+			filename: "file.txt",
+			ref:      "abc123",
+			expected: `git blame --porcelain abc123 -- "file.txt"`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			assert.Equal(t, s.expected, gitCmd.BlameCmdStr(s.filename, s.ref))
+		})
+	}
+}
+
+// TestParseBlame is a function.
+func TestParseBlame(t *testing.T) {
+	output := `3d063e7cb82115421acbcaf73e7da9c34d9c1cb7 1 1 1
+author Jesse Duffield
+author-mail <jesse@example.com>
+author-time 1617000000
+author-tz +0000
+committer Jesse Duffield
+committer-mail <jesse@example.com>
+committer-time 1617000000
+committer-tz +0000
+summary first commit
+boundary
+filename old name.txt
+	first line
+55a4409a3b8df9598e9cdc062393832da0d5d931 2 2 1
+author Someone Else
+author-mail <someone@example.com>
+author-time 1617100000
+author-tz +0000
+committer Someone Else
+committer-mail <someone@example.com>
+committer-time 1617100000
+committer-tz +0000
+summary second commit
+previous 3d063e7cb82115421acbcaf73e7da9c34d9c1cb7 old name.txt
+filename new.txt
+	second line
+3d063e7cb82115421acbcaf73e7da9c34d9c1cb7 2 3 1
+	third line
+0000000000000000000000000000000000000000 4 4 1
+author Not Committed Yet
+author-mail <not.committed.yet>
+author-time 1617200000
+author-tz +0000
+committer Not Committed Yet
+committer-mail <not.committed.yet>
+committer-time 1617200000
+committer-tz +0000
+summary Version of new.txt from new.txt
+previous 55a4409a3b8df9598e9cdc062393832da0d5d931 new.txt
+filename new.txt
+	
+`
+
+	lines := parseBlame(output)
+	assert.Len(t, lines, 4)
+
+	first := &models.BlameCommit{
+		Sha:           "3d063e7cb82115421acbcaf73e7da9c34d9c1cb7",
+		Author:        "Jesse Duffield",
+		UnixTimestamp: 1617000000,
+		Summary:       "first commit",
+	}
+	second := &models.BlameCommit{
+		Sha:              "55a4409a3b8df9598e9cdc062393832da0d5d931",
+		Author:           "Someone Else",
+		UnixTimestamp:    1617100000,
+		Summary:          "second commit",
+		PreviousSha:      "3d063e7cb82115421acbcaf73e7da9c34d9c1cb7",
+		PreviousFilename: "old name.txt",
+	}
+
+	assert.EqualValues(t, &models.BlameLine{Commit: first, Filename: "old name.txt", OriginalLineNumber: 1, LineNumber: 1, Content: "first line"}, lines[0])
+	assert.EqualValues(t, &models.BlameLine{Commit: second, Filename: "new.txt", OriginalLineNumber: 2, LineNumber: 2, Content: "second line"}, lines[1])
+	assert.EqualValues(t, &models.BlameLine{Commit: first, Filename: "old name.txt", OriginalLineNumber: 2, LineNumber: 3, Content: "third line"}, lines[2])
+
+	// both lines from the first commit should share the same commit struct
+	assert.True(t, lines[0].Commit == lines[2].Commit)
+
+	assert.True(t, lines[3].Commit.IsUncommitted())
+	assert.EqualValues(t, "", lines[3].Content)
+	assert.EqualValues(t, 4, lines[3].LineNumber)
+}
@@ -49,7 +49,7 @@ func NewCommitListBuilder(log *logrus.Entry, gitCommand *GitCommand, osCommand *
 // extractCommitFromLine takes a line from a git log and extracts the sha, message, date, and tag if present
 // then puts them into a commit object
 // example input:
-// 8ad01fe32fcc20f07bc6693f87aa4977c327f1e1|10 hours ago|Jesse Duffield| (HEAD -> master, tag: v0.15.2)|refresh commits when adding a tag
+// 8ad01fe32fcc20f07bc6693f87aa4977c327f1e1|1617000000|Jesse Duffield| (HEAD -> master, tag: v0.15.2)|6f0b32f1e8d0f8b7d3c07d9f9f5f7f2e3e1e6a3b|G|Jesse Duffield <jesse@example.com>|refresh commits when adding a tag
 func (c *CommitListBuilder) extractCommitFromLine(line string) *models.Commit {
 	split := strings.Split(line, SEPARATION_CHAR)
 
@@ -58,8 +58,10 @@ func (c *CommitListBuilder) extractCommitFromLine(line string) *models.Commit {
 	author := split[2]
 	extraInfo := strings.TrimSpace(split[3])
 	parentHashes := split[4]
+	signatureStatus := split[5]
+	signer := split[6]
 
-	message := strings.Join(split[5:], SEPARATION_CHAR)
+	message := strings.Join(split[7:], SEPARATION_CHAR)
 	tags := []string{}
 
 	if extraInfo != "" {
@@ -73,13 +75,15 @@ func (c *CommitListBuilder) extractCommitFromLine(line string) *models.Commit {
 	unitTimestampInt, _ := strconv.Atoi(unixTimestamp)
 
 	return &models.Commit{
-		Sha:           sha,
-		Name:          message,
-		Tags:          tags,
-		ExtraInfo:     extraInfo,
-		UnixTimestamp: int64(unitTimestampInt),
-		Author:        author,
-		Parents:       strings.Split(parentHashes, " "),
+		Sha:             sha,
+		Name:            message,
+		Tags:            tags,
+		ExtraInfo:       extraInfo,
+		UnixTimestamp:   int64(unitTimestampInt),
+		Author:          author,
+		Parents:         strings.Split(parentHashes, " "),
+		SignatureStatus: signatureStatus,
+		Signer:          signer,
 	}
 }
 
@@ -88,6 +92,12 @@ type GetCommitsOptions struct {
 	FilterPath           string
 	IncludeRebaseCommits bool
 	RefName              string // e.g. "HEAD" or "my_branch"
+	// e.g. "10,20" to only get the commits that touched those lines of the file
+	// at FilterPath
+	FilterLineRange string
+	// ensures no commit appears before its children, which we depend on to draw
+	// the commit graph, but makes git log slower on large repos
+	TopoOrder bool
 }
 
 func (c *CommitListBuilder) MergeRebasingCommits(commits []*models.Commit) ([]*models.Commit, error) {
@@ -114,6 +124,11 @@ func (c *CommitListBuilder) MergeRebasingCommits(commits []*models.Commit) ([]*m
 	if err != nil {
 		return nil, err
 	}
+	if c.GitCommand.Config.GetUserConfig().Git.UpdateRefs {
+		if err := c.setBranchHeads(rebasingCommits); err != nil {
+			return nil, err
+		}
+	}
 	if len(rebasingCommits) > 0 {
 		result = append(rebasingCommits, result...)
 	}
@@ -175,9 +190,35 @@ func (c *CommitListBuilder) GetCommits(opts GetCommitsOptions) ([]*models.Commit
 		return nil, err
 	}
 
+	if c.GitCommand.Config.GetUserConfig().Git.UpdateRefs {
+		// the rebasing commits have had their branch heads set already
+		if err := c.setBranchHeads(commits[len(rebasingCommits):]); err != nil {
+			return nil, err
+		}
+	}
+
 	return commits, nil
 }
 
+// setBranchHeads marks the commits that other local branches point to, which a
+// rebase with --update-refs will move. Commits yet to be rebased don't show up
+// in git log, so we also list the branches in their extra info.
+func (c *CommitListBuilder) setBranchHeads(commits []*models.Commit) error {
+	branchHeads, err := c.GitCommand.GetBranchHeadsToUpdate()
+	if err != nil {
+		return err
+	}
+
+	for _, commit := range commits {
+		commit.BranchHeads = branchHeads[commit.Sha]
+		if commit.Status == "rebasing" && len(commit.BranchHeads) > 0 {
+			commit.ExtraInfo = "(" + strings.Join(commit.BranchHeads, ", ") + ")"
+		}
+	}
+
+	return nil
+}
+
 // getRebasingCommits obtains the commits that we're in the process of rebasing
 func (c *CommitListBuilder) getRebasingCommits(rebaseMode string) ([]*models.Commit, error) {
 	switch rebaseMode {
@@ -233,16 +274,13 @@ func (c *CommitListBuilder) getNormalRebasingCommits() ([]*models.Commit, error)
 
 // git-rebase-todo example:
 // pick ac446ae94ee560bdb8d1d057278657b251aaef17 ac446ae
+// exec make test
 // pick afb893148791a2fbd8091aeb81deba4930c73031 afb8931
 
-// git-rebase-todo.backup example:
-// pick 49cbba374296938ea86bbd4bf4fee2f6ba5cccf6 third commit on master
-// pick ac446ae94ee560bdb8d1d057278657b251aaef17 blah  commit on master
-// pick afb893148791a2fbd8091aeb81deba4930c73031 fourth commit on master
-
-// getInteractiveRebasingCommits takes our git-rebase-todo and our git-rebase-todo.backup files
-// and extracts out the sha and names of commits that we still have to go
-// in the rebase:
+// getInteractiveRebasingCommits takes our git-rebase-todo file and extracts out
+// the todos that we still have to go in the rebase. Alongside the commits, this
+// includes entries like exec and break lines, so that every line of the file is
+// shown.
 func (c *CommitListBuilder) getInteractiveRebasingCommits() ([]*models.Commit, error) {
 	bytesContent, err := ioutil.ReadFile(filepath.Join(c.GitCommand.DotGitDir, "rebase-merge/git-rebase-todo"))
 	if err != nil {
@@ -253,20 +291,8 @@ func (c *CommitListBuilder) getInteractiveRebasingCommits() ([]*models.Commit, e
 
 	commits := []*models.Commit{}
 	lines := strings.Split(string(bytesContent), "\n")
-	for _, line := range lines {
-		if line == "" || line == "noop" {
-			return commits, nil
-		}
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
-		splitLine := strings.Split(line, " ")
-		commits = append([]*models.Commit{{
-			Sha:    splitLine[1],
-			Name:   strings.Join(splitLine[2:], " "),
-			Status: "rebasing",
-			Action: splitLine[0],
-		}}, commits...)
+	for _, index := range todoLineIndices(lines) {
+		commits = append([]*models.Commit{parseTodoLine(lines[index])}, commits...)
 	}
 
 	return commits, nil
@@ -357,18 +383,38 @@ func (c *CommitListBuilder) getLogCmd(opts GetCommitsOptions) *exec.Cmd {
 
 	filterFlag := ""
 	if opts.FilterPath != "" {
-		filterFlag = fmt.Sprintf(" --follow -- %s", c.OSCommand.Quote(opts.FilterPath))
+		if opts.FilterLineRange != "" {
+			// -L can't be combined with --follow, and it shows each commit's patch
+			// unless we tell it otherwise
+			filterFlag = fmt.Sprintf(" --no-patch -L %s:%s", opts.FilterLineRange, c.OSCommand.Quote(opts.FilterPath))
+		} else {
+			filterFlag = fmt.Sprintf(" --follow -- %s", c.OSCommand.Quote(opts.FilterPath))
+		}
+	}
+
+	// verifying signatures can be slow, so unless the user has asked for them
+	// we leave those fields blank
+	signatureStatusFormat, signerFormat := "", ""
+	if c.GitCommand.Config.GetUserConfig().Git.Log.ShowSignature {
+		signatureStatusFormat, signerFormat = "%G?", "%GS"
+	}
+
+	prettyFormat := strings.Join(
+		[]string{"%H", "%at", "%aN", "%d", "%p", signatureStatusFormat, signerFormat, "%s"},
+		SEPARATION_CHAR,
+	)
+
+	topoOrderFlag := ""
+	if opts.TopoOrder {
+		topoOrderFlag = " --topo-order"
 	}
 
 	return c.OSCommand.ExecutableFromString(
 		fmt.Sprintf(
-			"git log %s --oneline --pretty=format:\"%%H%s%%at%s%%aN%s%%d%s%%p%s%%s\" %s --abbrev=%d --date=unix %s",
+			"git log %s%s --oneline --pretty=format:\"%s\" %s --abbrev=%d --date=unix %s",
 			opts.RefName,
-			SEPARATION_CHAR,
-			SEPARATION_CHAR,
-			SEPARATION_CHAR,
-			SEPARATION_CHAR,
-			SEPARATION_CHAR,
+			topoOrderFlag,
+			prettyFormat,
 			limitFlag,
 			20,
 			filterFlag,
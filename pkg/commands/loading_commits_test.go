@@ -4,6 +4,7 @@ import (
 	"os/exec"
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/secureexec"
@@ -112,3 +113,96 @@ func TestCommitListBuilderGetMergeBase(t *testing.T) {
 		})
 	}
 }
+
+// TestCommitListBuilderGetLogCmd is a function.
+func TestCommitListBuilderGetLogCmd(t *testing.T) {
+	type scenario struct {
+		testName       string
+		opts           GetCommitsOptions
+		expectedPrefix []string
+		expectedSuffix []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "No filter",
+			opts:           GetCommitsOptions{RefName: "HEAD"},
+			expectedPrefix: []string{"git", "log", "HEAD", "--oneline"},
+			expectedSuffix: []string{"--abbrev=20", "--date=unix"},
+		},
+		{
+			testName:       "Topological order for the graph",
+			opts:           GetCommitsOptions{RefName: "HEAD", TopoOrder: true},
+			expectedPrefix: []string{"git", "log", "HEAD", "--topo-order", "--oneline"},
+			expectedSuffix: []string{"--abbrev=20", "--date=unix"},
+		},
+		{
+			testName:       "Filtering by path",
+			opts:           GetCommitsOptions{RefName: "HEAD", FilterPath: "foo.go"},
+			expectedPrefix: []string{"git", "log", "HEAD", "--oneline"},
+			expectedSuffix: []string{"--abbrev=20", "--date=unix", "--follow", "--", "foo.go"},
+		},
+		{
+			testName:       "Filtering by line range",
+			opts:           GetCommitsOptions{RefName: "HEAD", FilterPath: "foo.go", FilterLineRange: "10,20"},
+			expectedPrefix: []string{"git", "log", "HEAD", "--oneline"},
+			expectedSuffix: []string{"--abbrev=20", "--date=unix", "--no-patch", "-L", "10,20:foo.go"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			c := NewDummyCommitListBuilder()
+			args := c.getLogCmd(s.opts).Args
+			assert.EqualValues(t, s.expectedPrefix, args[:len(s.expectedPrefix)])
+			assert.EqualValues(t, s.expectedSuffix, args[len(args)-len(s.expectedSuffix):])
+		})
+	}
+}
+
+// TestCommitListBuilderExtractCommitFromLine is a function.
+func TestCommitListBuilderExtractCommitFromLine(t *testing.T) {
+	type scenario struct {
+		testName string
+		line     string
+		expected *models.Commit
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Without signature",
+			line:     "abc123|1617000000|Jesse Duffield| (HEAD -> master)|def456|||my commit",
+			expected: &models.Commit{
+				Sha:           "abc123",
+				Name:          "my commit",
+				Tags:          []string{},
+				ExtraInfo:     "(HEAD -> master)",
+				Author:        "Jesse Duffield",
+				UnixTimestamp: 1617000000,
+				Parents:       []string{"def456"},
+			},
+		},
+		{
+			testName: "With signature, and separator in message",
+			line:     "abc123|1617000000|Jesse Duffield||def456 ghi789|G|Jesse Duffield <jesse@example.com>|merge a|b",
+			expected: &models.Commit{
+				Sha:             "abc123",
+				Name:            "merge a|b",
+				Tags:            []string{},
+				ExtraInfo:       "",
+				Author:          "Jesse Duffield",
+				UnixTimestamp:   1617000000,
+				Parents:         []string{"def456", "ghi789"},
+				SignatureStatus: "G",
+				Signer:          "Jesse Duffield <jesse@example.com>",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			c := NewDummyCommitListBuilder()
+			assert.EqualValues(t, s.expected, c.extractCommitFromLine(s.line))
+		})
+	}
+}
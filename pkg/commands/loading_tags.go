@@ -1,34 +1,59 @@
 package commands
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
-	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
+// each tag is terminated by a \x01 byte rather than a newline, because an
+// annotated tag's message may itself contain newlines. Within a tag, fields
+// are separated by null bytes.
+const tagFormat = `%(refname:strip=2)%00%(objecttype)%00%(taggername)%00%(taggerdate:unix)%00%(contents:subject)%00%(contents:body)%01`
+
 func (c *GitCommand) GetTags() ([]*models.Tag, error) {
-	// get remote branches, sorted  by creation date (descending)
+	// get tags, sorted by creation date (descending)
 	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
-	remoteBranchesStr, err := c.OSCommand.RunCommandWithOutput(`git tag --list --sort=-creatordate`)
+	cmdStr := `git for-each-ref --sort=-creatordate --format="` + tagFormat + `" refs/tags`
+	tagsStr, err := c.OSCommand.RunCommandWithOutput(cmdStr)
 	if err != nil {
 		return nil, err
 	}
 
-	content := utils.TrimTrailingNewline(remoteBranchesStr)
-	if content == "" {
-		return nil, nil
-	}
+	return parseTags(tagsStr), nil
+}
 
-	split := strings.Split(content, "\n")
+func parseTags(output string) []*models.Tag {
+	tags := []*models.Tag{}
+
+	for _, record := range strings.Split(output, "\x01") {
+		record = strings.TrimPrefix(record, "\n")
+		if record == "" {
+			continue
+		}
 
-	// first step is to get our remotes from go-git
-	tags := make([]*models.Tag, len(split))
-	for i, tagName := range split {
-		tags[i] = &models.Tag{
-			Name: tagName,
+		fields := strings.Split(record, "\x00")
+		if len(fields) < 6 {
+			continue
 		}
+
+		tag := &models.Tag{Name: fields[0]}
+
+		// a lightweight tag points directly at a commit, meaning the remaining
+		// fields would describe the commit rather than the tag
+		if fields[1] == "tag" {
+			tag.Kind = models.AnnotatedTag
+			tag.Tagger = fields[2]
+			tag.UnixTimestamp, _ = strconv.ParseInt(fields[3], 10, 64)
+			tag.Message = fields[4]
+			if body := strings.TrimSpace(fields[5]); body != "" {
+				tag.Message += "\n\n" + body
+			}
+		}
+
+		tags = append(tags, tag)
 	}
 
-	return tags, nil
+	return tags
 }
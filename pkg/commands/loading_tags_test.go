@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseTags is a function.
+func TestParseTags(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected []*models.Tag
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "No tags",
+			output:   "",
+			expected: []*models.Tag{},
+		},
+		{
+			testName: "Lightweight and annotated tags",
+			output: "v1.1.0\x00tag\x00Jesse Duffield\x001617000000\x00Release v1.1.0\x00Fixes a bug\nand another\n\x01\n" +
+				"v1.0.0\x00commit\x00\x00\x00Commit subject\x00\x01\n",
+			expected: []*models.Tag{
+				{
+					Name:          "v1.1.0",
+					Kind:          models.AnnotatedTag,
+					Tagger:        "Jesse Duffield",
+					UnixTimestamp: 1617000000,
+					Message:       "Release v1.1.0\n\nFixes a bug\nand another",
+				},
+				{
+					Name: "v1.0.0",
+					Kind: models.LightweightTag,
+				},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.EqualValues(t, s.expected, parseTags(s.output))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// `git worktree list --porcelain` gives us something like:
+// worktree /path/to/main
+// HEAD 4c6ff0e7c5b2b0bc1c1c8c5a6a0a3a1e3e4b5c6d
+// branch refs/heads/master
+//
+// worktree /path/to/linked
+// HEAD 1234abc1234abc1234abc1234abc1234abc1234a
+// detached
+//
+// The first entry is always the main worktree.
+
+func (c *GitCommand) GetWorktrees() ([]*models.Worktree, error) {
+	output, err := c.OSCommand.RunCommandWithOutput("git worktree list --porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWorktrees(output, currentDir), nil
+}
+
+func parseWorktrees(output string, currentDir string) []*models.Worktree {
+	content := utils.TrimTrailingNewline(strings.Replace(output, "\r\n", "\n", -1))
+	if content == "" {
+		return nil
+	}
+
+	worktrees := []*models.Worktree{}
+	var current *models.Worktree
+	for _, line := range strings.Split(content, "\n") {
+		if line == "" {
+			current = nil
+			continue
+		}
+
+		if strings.HasPrefix(line, "worktree ") {
+			path := strings.TrimPrefix(line, "worktree ")
+			current = &models.Worktree{
+				Path:      path,
+				IsMain:    len(worktrees) == 0,
+				IsCurrent: filepath.Clean(path) == filepath.Clean(currentDir),
+			}
+			worktrees = append(worktrees, current)
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "HEAD "):
+			current.Head = strings.TrimPrefix(line, "HEAD ")
+		case strings.HasPrefix(line, "branch "):
+			current.Branch = strings.TrimPrefix(strings.TrimPrefix(line, "branch "), "refs/heads/")
+		case line == "bare":
+			current.Bare = true
+		case line == "locked" || strings.HasPrefix(line, "locked "):
+			current.Locked = true
+		case line == "prunable" || strings.HasPrefix(line, "prunable "):
+			current.Prunable = true
+		}
+	}
+
+	return worktrees
+}
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/secureexec"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitCommandGetWorktrees is a function.
+func TestGitCommandGetWorktrees(t *testing.T) {
+	gitCmd := NewDummyGitCommand()
+	gitCmd.OSCommand.Command = func(cmd string, args ...string) *exec.Cmd {
+		assert.EqualValues(t, "git", cmd)
+		assert.EqualValues(t, []string{"worktree", "list", "--porcelain"}, args)
+
+		return secureexec.Command("echo")
+	}
+
+	worktrees, err := gitCmd.GetWorktrees()
+	assert.NoError(t, err)
+	assert.Len(t, worktrees, 0)
+}
+
+// TestParseWorktrees is a function.
+func TestParseWorktrees(t *testing.T) {
+	type scenario struct {
+		testName   string
+		output     string
+		currentDir string
+		expected   []*models.Worktree
+	}
+
+	scenarios := []scenario{
+		{
+			"no output",
+			"",
+			"/repo",
+			nil,
+		},
+		{
+			"main worktree only",
+			"worktree /repo\nHEAD 4c6ff0e7c5b2b0bc1c1c8c5a6a0a3a1e3e4b5c6d\nbranch refs/heads/master\n",
+			"/repo",
+			[]*models.Worktree{
+				{
+					Path:      "/repo",
+					Head:      "4c6ff0e7c5b2b0bc1c1c8c5a6a0a3a1e3e4b5c6d",
+					Branch:    "master",
+					IsMain:    true,
+					IsCurrent: true,
+				},
+			},
+		},
+		{
+			"several worktrees",
+			"worktree /repo\n" +
+				"HEAD 4c6ff0e7c5b2b0bc1c1c8c5a6a0a3a1e3e4b5c6d\n" +
+				"branch refs/heads/master\n" +
+				"\n" +
+				"worktree /worktrees/feature\n" +
+				"HEAD 1234abc1234abc1234abc1234abc1234abc1234a\n" +
+				"branch refs/heads/feature/blah\n" +
+				"locked\n" +
+				"\n" +
+				"worktree /worktrees/detached\n" +
+				"HEAD 5678def5678def5678def5678def5678def5678d\n" +
+				"detached\n" +
+				"prunable gitdir file points to non-existent location\n",
+			"/worktrees/feature/",
+			[]*models.Worktree{
+				{
+					Path:   "/repo",
+					Head:   "4c6ff0e7c5b2b0bc1c1c8c5a6a0a3a1e3e4b5c6d",
+					Branch: "master",
+					IsMain: true,
+				},
+				{
+					Path:      "/worktrees/feature",
+					Head:      "1234abc1234abc1234abc1234abc1234abc1234a",
+					Branch:    "feature/blah",
+					IsCurrent: true,
+					Locked:    true,
+				},
+				{
+					Path:     "/worktrees/detached",
+					Head:     "5678def5678def5678def5678def5678def5678d",
+					Prunable: true,
+				},
+			},
+		},
+		{
+			"bare main repo",
+			"worktree /repo.git\nbare\n\nworktree /worktrees/master\nHEAD 4c6ff0e7c5b2b0bc1c1c8c5a6a0a3a1e3e4b5c6d\nbranch refs/heads/master\n",
+			"/worktrees/master",
+			[]*models.Worktree{
+				{
+					Path:   "/repo.git",
+					IsMain: true,
+					Bare:   true,
+				},
+				{
+					Path:      "/worktrees/master",
+					Head:      "4c6ff0e7c5b2b0bc1c1c8c5a6a0a3a1e3e4b5c6d",
+					Branch:    "master",
+					IsCurrent: true,
+				},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.EqualValues(t, s.expected, parseWorktrees(s.output, s.currentDir))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package models
+
+import "sort"
+
+type BisectStatus int
+
+const (
+	BisectStatusUntested BisectStatus = iota
+	BisectStatusGood
+	BisectStatusBad
+	BisectStatusSkipped
+)
+
+// BisectInfo : the state of an in-progress git bisect
+type BisectInfo struct {
+	// the ref that was checked out when the bisect started. This is where we
+	// return to upon resetting
+	Start string
+
+	// the sha of the commit that git has checked out for us to test
+	Current string
+
+	// the terms used for marking commits. Defaults to 'bad' and 'good' but can
+	// be customised via `git bisect start --term-new --term-old`
+	NewTerm string
+	OldTerm string
+
+	// map of commit sha to the status it has been marked with
+	statuses map[string]BisectStatus
+}
+
+func NewBisectInfo() *BisectInfo {
+	return &BisectInfo{
+		NewTerm:  "bad",
+		OldTerm:  "good",
+		statuses: map[string]BisectStatus{},
+	}
+}
+
+func (b *BisectInfo) Bisecting() bool {
+	return b.Start != ""
+}
+
+func (b *BisectInfo) SetStatus(sha string, status BisectStatus) {
+	b.statuses[sha] = status
+}
+
+func (b *BisectInfo) Status(sha string) BisectStatus {
+	return b.statuses[sha]
+}
+
+// BadSha returns the sha of the newest commit marked bad. Git only keeps track
+// of one bad commit at a time, moving it back through history as we go.
+func (b *BisectInfo) BadSha() string {
+	for sha, status := range b.statuses {
+		if status == BisectStatusBad {
+			return sha
+		}
+	}
+
+	return ""
+}
+
+func (b *BisectInfo) ShasWithStatus(status BisectStatus) []string {
+	shas := []string{}
+	for sha, s := range b.statuses {
+		if s == status {
+			shas = append(shas, sha)
+		}
+	}
+	sort.Strings(shas)
+
+	return shas
+}
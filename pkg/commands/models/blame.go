@@ -0,0 +1,47 @@
+package models
+
+import "strings"
+
+// BlameCommit : the details of a commit shared by every line blamed on it
+type BlameCommit struct {
+	Sha           string
+	Author        string
+	UnixTimestamp int64
+	Summary       string
+
+	// the commit and path to blame in order to see what the lines of this
+	// commit looked like beforehand. These are empty for a boundary commit
+	// i.e. one which has no parents within the blamed range
+	PreviousSha      string
+	PreviousFilename string
+}
+
+func (c *BlameCommit) ShortSha() string {
+	if len(c.Sha) < 8 {
+		return c.Sha
+	}
+	return c.Sha[:8]
+}
+
+// IsUncommitted tells us whether the line exists only in the working tree.
+// Git represents this with a sha of all zeroes
+func (c *BlameCommit) IsUncommitted() bool {
+	return strings.Trim(c.Sha, "0") == ""
+}
+
+// BlameLine : a line of a file along with the commit that last changed it
+type BlameLine struct {
+	Commit *BlameCommit
+
+	// the path of the file as of the blamed commit, which may differ from the
+	// current path if the file has since been renamed
+	Filename string
+
+	// the line number in the blamed commit's version of the file
+	OriginalLineNumber int
+
+	// the line number in the version of the file being blamed
+	LineNumber int
+
+	Content string
+}
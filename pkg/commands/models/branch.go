@@ -11,6 +11,8 @@ type Branch struct {
 	Pullables    string
 	UpstreamName string
 	Head         bool
+	// the open pull request for the branch, if we know of one
+	PullRequest *PullRequest
 }
 
 func (b *Branch) RefName() string {
@@ -7,7 +7,7 @@ type Commit struct {
 	Sha           string
 	Name          string
 	Status        string // one of "unpushed", "pushed", "merged", "rebasing" or "selected"
-	Action        string // one of "", "pick", "edit", "squash", "reword", "drop", "fixup"
+	Action        string // one of "", "pick", "edit", "squash", "reword", "drop", "fixup", or a todo command like "exec"
 	Tags          []string
 	ExtraInfo     string // something like 'HEAD -> master, tag: v0.15.2'
 	Author        string
@@ -15,6 +15,17 @@ type Commit struct {
 
 	// SHAs of parent commits (will be multiple if it's a merge commit)
 	Parents []string
+
+	// the result of verifying the commit's signature, as given by git's %G?
+	// placeholder e.g. "G" for a good signature or "N" for no signature. Empty
+	// if we didn't ask git to verify signatures
+	SignatureStatus string
+	// the name of the signer, as given by git's %GS placeholder
+	Signer string
+
+	// local branches whose heads are at this commit and which will be moved
+	// along with it by a rebase. Only set if the user has enabled updateRefs
+	BranchHeads []string
 }
 
 func (c *Commit) ShortSha() string {
@@ -39,3 +50,21 @@ func (c *Commit) Description() string {
 func (c *Commit) IsMerge() bool {
 	return len(c.Parents) > 1
 }
+
+// IsTodoCommand tells us whether this is a rebase todo entry that isn't a
+// commit to be picked, e.g. an exec or a break. Its name holds the rest of the
+// entry, e.g. the command to exec.
+func (c *Commit) IsTodoCommand() bool {
+	return c.Status == "rebasing" && !IsCommitAction(c.Action)
+}
+
+// IsCommitAction tells us whether the given rebase todo action applies to a
+// single commit, meaning the action can be swapped for another such action
+func IsCommitAction(action string) bool {
+	switch action {
+	case "pick", "reword", "edit", "squash", "fixup", "drop":
+		return true
+	default:
+		return false
+	}
+}
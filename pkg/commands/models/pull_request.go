@@ -0,0 +1,22 @@
+package models
+
+const (
+	PULL_REQUEST_OPEN  = "open"
+	PULL_REQUEST_DRAFT = "draft"
+)
+
+const (
+	REVIEW_APPROVED          = "approved"
+	REVIEW_CHANGES_REQUESTED = "changes requested"
+)
+
+// PullRequest is an open pull request (or merge request) on the repo's hosting
+// service, as reported by its API
+type PullRequest struct {
+	Number int
+	// one of 'open' | 'draft'
+	State string
+	// one of 'approved' | 'changes requested', or empty if neither
+	ReviewStatus string
+	URL          string
+}
@@ -1,8 +1,22 @@
 package models
 
+type TagKind int
+
+const (
+	LightweightTag TagKind = iota
+	AnnotatedTag
+)
+
 // Tag : A git tag
 type Tag struct {
 	Name string
+	Kind TagKind
+
+	// the following are only set for annotated tags, given that lightweight
+	// tags are just a ref pointing at a commit
+	Tagger        string
+	UnixTimestamp int64
+	Message       string
 }
 
 func (t *Tag) RefName() string {
@@ -16,3 +30,7 @@ func (t *Tag) ID() string {
 func (t *Tag) Description() string {
 	return "tag " + t.Name
 }
+
+func (t *Tag) IsAnnotated() bool {
+	return t.Kind == AnnotatedTag
+}
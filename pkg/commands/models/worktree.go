@@ -0,0 +1,33 @@
+package models
+
+import "path/filepath"
+
+// Worktree : A git worktree
+type Worktree struct {
+	Path string
+	// Head is the SHA of the worktree's checked out commit
+	Head string
+	// Branch is empty when the worktree has a detached HEAD
+	Branch    string
+	IsMain    bool
+	IsCurrent bool
+	Bare      bool
+	Locked    bool
+	Prunable  bool
+}
+
+func (w *Worktree) RefName() string {
+	return w.Path
+}
+
+func (w *Worktree) ID() string {
+	return w.RefName()
+}
+
+func (w *Worktree) Description() string {
+	return w.RefName()
+}
+
+func (w *Worktree) Name() string {
+	return filepath.Base(w.Path)
+}
@@ -0,0 +1,143 @@
+package oscommands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Rather than scraping git's output for credential prompts, we set GIT_ASKPASS
+// and SSH_ASKPASS to lazygit itself, so that whenever git or ssh needs a
+// username, password or passphrase they run a second lazygit process with the
+// prompt as its argument. That process sends the prompt over a local socket to
+// the lazygit that ran the command, which asks the user and sends back the
+// answer for the askpass process to print.
+
+const (
+	ASKPASS_CLIENT_COMMAND = "ASKPASS"
+	ASKPASS_SOCKET_ENV_VAR = "LAZYGIT_ASKPASS_SOCKET"
+)
+
+type askpassRequest struct {
+	Prompt string `json:"prompt"`
+}
+
+type askpassResponse struct {
+	Response string `json:"response"`
+}
+
+// RunCommandWithCredentials runs a command that may need credentials, such as
+// a push or a fetch, talking to the remote at remoteURL. Each time git or ssh
+// asks for one, promptUserForCredential is called with the remote's URL and
+// their prompt, e.g. "Password for 'https://github.com': ", and whatever it
+// returns is handed back as the answer.
+func (c *OSCommand) RunCommandWithCredentials(command string, remoteURL string, promptUserForCredential func(string, string) string) error {
+	socketPath, closeServer, err := startAskpassServer(func(prompt string) string {
+		return promptUserForCredential(remoteURL, prompt)
+	})
+	if err != nil {
+		return err
+	}
+	defer closeServer()
+
+	lazygitPath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := c.ExecutableFromString(command)
+	cmd.Env = append(
+		cmd.Env,
+		"LAZYGIT_CLIENT_COMMAND="+ASKPASS_CLIENT_COMMAND,
+		ASKPASS_SOCKET_ENV_VAR+"="+socketPath,
+		"GIT_ASKPASS="+lazygitPath,
+		"SSH_ASKPASS="+lazygitPath,
+		// without this, ssh only uses SSH_ASKPASS when it has no terminal and
+		// DISPLAY is set (requires OpenSSH 8.4)
+		"SSH_ASKPASS_REQUIRE=force",
+	)
+	// anything that still tries to prompt on the terminal would mess up our
+	// UI, so we don't give it one
+	detachFromTerminal(cmd)
+
+	_, err = c.RunExecutableWithOutput(cmd)
+	return err
+}
+
+// startAskpassServer listens on a fresh socket for askpass requests, returning
+// the socket's path and a function to stop listening
+func startAskpassServer(promptUserForCredential func(string) string) (string, func(), error) {
+	socketDir, err := ioutil.TempDir("", "lazygit-askpass")
+	if err != nil {
+		return "", nil, err
+	}
+
+	socketPath := filepath.Join(socketDir, "socket")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		os.RemoveAll(socketDir)
+		return "", nil, err
+	}
+
+	go utils.Safe(func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				// the listener has been closed
+				return
+			}
+
+			// git and ssh ask for one thing at a time, so there's no need to
+			// handle connections concurrently
+			_ = handleAskpassConnection(conn, promptUserForCredential)
+		}
+	})
+
+	closeServer := func() {
+		listener.Close()
+		os.RemoveAll(socketDir)
+	}
+
+	return socketPath, closeServer, nil
+}
+
+func handleAskpassConnection(conn net.Conn, promptUserForCredential func(string) string) error {
+	defer conn.Close()
+
+	var request askpassRequest
+	if err := json.NewDecoder(conn).Decode(&request); err != nil {
+		return err
+	}
+
+	response := askpassResponse{Response: promptUserForCredential(request.Prompt)}
+	return json.NewEncoder(conn).Encode(response)
+}
+
+// RunAskpassClient is what runs when git or ssh call lazygit as their askpass
+// program. It asks the lazygit listening on the given socket to prompt the
+// user, and writes the answer to output for git or ssh to read.
+func RunAskpassClient(socketPath string, prompt string, output io.Writer) error {
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := json.NewEncoder(conn).Encode(askpassRequest{Prompt: prompt}); err != nil {
+		return err
+	}
+
+	var response askpassResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(output, response.Response)
+	return err
+}
@@ -0,0 +1,14 @@
+// +build !windows
+
+package oscommands
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detachFromTerminal runs the command in a new session, so that it has no
+// controlling terminal to prompt on
+func detachFromTerminal(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+}
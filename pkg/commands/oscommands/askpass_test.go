@@ -0,0 +1,27 @@
+package oscommands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAskpass is a function.
+func TestAskpass(t *testing.T) {
+	prompts := []string{}
+	socketPath, closeServer, err := startAskpassServer(func(prompt string) string {
+		prompts = append(prompts, prompt)
+		return "hunter2"
+	})
+	assert.NoError(t, err)
+	defer closeServer()
+
+	output := &bytes.Buffer{}
+	assert.NoError(t, RunAskpassClient(socketPath, "Password for 'https://github.com': ", output))
+	assert.Equal(t, []string{"Password for 'https://github.com': "}, prompts)
+	assert.Equal(t, "hunter2\n", output.String())
+
+	closeServer()
+	assert.Error(t, RunAskpassClient(socketPath, "Username for 'https://github.com': ", output))
+}
@@ -0,0 +1,9 @@
+package oscommands
+
+import (
+	"os/exec"
+)
+
+// detachFromTerminal does nothing on windows, where git and ssh use the askpass
+// program rather than the console when it's set
+func detachFromTerminal(cmd *exec.Cmd) {}
@@ -0,0 +1,95 @@
+package oscommands
+
+import "sync"
+
+// CredentialCache remembers the answers to credential prompts for as long as
+// lazygit is running, so that we only ask once for each remote. The answers are
+// keyed by the remote's URL as well as the prompt, because not every prompt
+// names the remote (ssh's passphrase prompts name the key instead). Nothing in
+// here is ever written to disk.
+type CredentialCache struct {
+	mutex   sync.Mutex
+	answers map[credentialKey]string
+}
+
+type credentialKey struct {
+	remoteURL string
+	prompt    string
+}
+
+func NewCredentialCache() *CredentialCache {
+	return &CredentialCache{answers: map[credentialKey]string{}}
+}
+
+// NewSession is to be called for each command that may ask for credentials,
+// and the command's answers looked up and stored through the session
+func (c *CredentialCache) NewSession() *CredentialSession {
+	return &CredentialSession{cache: c}
+}
+
+// Clear forgets every answer
+func (c *CredentialCache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.answers = map[credentialKey]string{}
+}
+
+// CredentialSession keeps track of the answers that a single command used, so
+// that if the command fails we can forget them, in case they were wrong,
+// without touching the answers that any other command running at the same time
+// is using.
+type CredentialSession struct {
+	cache *CredentialCache
+	// only used by the command's askpass server, one prompt at a time, but we
+	// lock it anyway because CommandFinished is called from elsewhere
+	mutex sync.Mutex
+	used  []credentialKey
+}
+
+func (s *CredentialSession) Get(remoteURL string, prompt string) (string, bool) {
+	key := credentialKey{remoteURL: remoteURL, prompt: prompt}
+
+	s.cache.mutex.Lock()
+	answer, ok := s.cache.answers[key]
+	s.cache.mutex.Unlock()
+
+	if ok {
+		s.use(key)
+	}
+
+	return answer, ok
+}
+
+func (s *CredentialSession) Set(remoteURL string, prompt string, answer string) {
+	key := credentialKey{remoteURL: remoteURL, prompt: prompt}
+
+	s.cache.mutex.Lock()
+	s.cache.answers[key] = answer
+	s.cache.mutex.Unlock()
+
+	s.use(key)
+}
+
+func (s *CredentialSession) use(key credentialKey) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.used = append(s.used, key)
+}
+
+// CommandFinished is to be called once the command has finished, with the
+// command's error
+func (s *CredentialSession) CommandFinished(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err != nil {
+		s.cache.mutex.Lock()
+		for _, key := range s.used {
+			delete(s.cache.answers, key)
+		}
+		s.cache.mutex.Unlock()
+	}
+	s.used = nil
+}
@@ -0,0 +1,84 @@
+package oscommands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCredentialCache is a function.
+func TestCredentialCache(t *testing.T) {
+	githubURL := "https://github.com/me/repo.git"
+	usernamePrompt := "Username for 'https://github.com': "
+	passwordPrompt := "Password for 'https://me@github.com': "
+
+	cache := NewCredentialCache()
+	session := cache.NewSession()
+	_, ok := session.Get(githubURL, usernamePrompt)
+	assert.False(t, ok)
+
+	session.Set(githubURL, usernamePrompt, "me")
+	session.Set(githubURL, passwordPrompt, "hunter2")
+	session.CommandFinished(nil)
+
+	session = cache.NewSession()
+	answer, ok := session.Get(githubURL, passwordPrompt)
+	assert.True(t, ok)
+	assert.Equal(t, "hunter2", answer)
+
+	// only the answers used by a failed command are forgotten
+	session.CommandFinished(errors.New("Authentication failed"))
+	session = cache.NewSession()
+	_, ok = session.Get(githubURL, passwordPrompt)
+	assert.False(t, ok)
+	answer, ok = session.Get(githubURL, usernamePrompt)
+	assert.True(t, ok)
+	assert.Equal(t, "me", answer)
+
+	cache.Clear()
+	_, ok = session.Get(githubURL, usernamePrompt)
+	assert.False(t, ok)
+}
+
+// TestCredentialCacheKeyedByRemoteURL is a function.
+func TestCredentialCacheKeyedByRemoteURL(t *testing.T) {
+	passphrasePrompt := "Enter passphrase for key '/home/me/.ssh/id_rsa': "
+
+	cache := NewCredentialCache()
+	session := cache.NewSession()
+	session.Set("git@github.com:me/repo.git", passphrasePrompt, "hunter2")
+	session.CommandFinished(nil)
+
+	session = cache.NewSession()
+	_, ok := session.Get("git@gitlab.com:me/repo.git", passphrasePrompt)
+	assert.False(t, ok)
+	answer, ok := session.Get("git@github.com:me/repo.git", passphrasePrompt)
+	assert.True(t, ok)
+	assert.Equal(t, "hunter2", answer)
+}
+
+// TestCredentialSessions is a function.
+func TestCredentialSessions(t *testing.T) {
+	githubURL := "https://github.com/me/repo.git"
+	gitlabURL := "https://gitlab.com/me/repo.git"
+	passwordPrompt := "Password: "
+
+	cache := NewCredentialCache()
+	pushSession := cache.NewSession()
+	fetchSession := cache.NewSession()
+	pushSession.Set(githubURL, passwordPrompt, "hunter2")
+	fetchSession.Set(gitlabURL, passwordPrompt, "wrong")
+
+	// the failed fetch shouldn't forget the answer used by the push that's
+	// running at the same time
+	fetchSession.CommandFinished(errors.New("Authentication failed"))
+	pushSession.CommandFinished(nil)
+
+	session := cache.NewSession()
+	answer, ok := session.Get(githubURL, passwordPrompt)
+	assert.True(t, ok)
+	assert.Equal(t, "hunter2", answer)
+	_, ok = session.Get(gitlabURL, passwordPrompt)
+	assert.False(t, ok)
+}
@@ -145,6 +145,116 @@ func (hunk *PatchHunk) LineNumberOfLine(idx int) int {
 	return hunk.newStart + offset
 }
 
+// OldLineNumberOfLine is like LineNumberOfLine, but for the version of the file
+// from before the patch is applied. Lines added by the patch aren't in that
+// version, so for those you get the number of the line that follows.
+func (hunk *PatchHunk) OldLineNumberOfLine(idx int) int {
+	lines := hunk.bodyLines[0 : idx-hunk.FirstLineIdx-1]
+
+	offset := nLinesWithPrefix(lines, []string{"-", " "})
+
+	return hunk.oldStart + offset
+}
+
+// OldStart returns the line number at which the hunk starts in the version of
+// the file from before the patch is applied. For a hunk with no context that
+// only adds lines, this is the line after which they're added
+func (hunk *PatchHunk) OldStart() int {
+	return hunk.oldStart
+}
+
+// RemovedLineNumbers returns the line numbers, in the version of the file from
+// before the patch is applied, of the lines that the hunk removes
+func (hunk *PatchHunk) RemovedLineNumbers() []int {
+	result := []int{}
+	lineNumber := hunk.oldStart
+	for _, line := range hunk.bodyLines {
+		if line == "" {
+			break
+		}
+
+		switch line[:1] {
+		case "-":
+			result = append(result, lineNumber)
+			lineNumber++
+		case " ":
+			lineNumber++
+		}
+	}
+
+	return result
+}
+
+// OldLineNumberRange maps a range of line numbers in the version of the file
+// from after the diff is applied onto the version from before it. Lines added by
+// the diff have nothing to map onto, so the range shrinks to the lines within it
+// that the diff didn't add. If there are none, ok is false.
+func OldLineNumberRange(diff string, firstLine int, lastLine int) (int, int, bool) {
+	hunks := GetHunksFromDiff(diff)
+
+	oldFirstLine, _ := oldLineNumber(hunks, firstLine)
+	oldLastLine, added := oldLineNumber(hunks, lastLine)
+	if added {
+		oldLastLine--
+	}
+
+	return oldFirstLine, oldLastLine, oldFirstLine <= oldLastLine
+}
+
+// oldLineNumber returns the line number in the version of the file from before
+// the hunks are applied of the given line, or, if the hunks added that line,
+// of the line that follows it
+func oldLineNumber(hunks []*PatchHunk, lineNumber int) (int, bool) {
+	offset := 0
+	for _, hunk := range hunks {
+		if lineNumber < hunk.newStart {
+			break
+		}
+
+		oldLine, newLine := hunk.oldStart, hunk.newStart
+		if !hunk.hasOldLines() {
+			// the hunk starts after oldStart when that side is empty
+			oldLine++
+		}
+
+		for _, line := range hunk.bodyLines {
+			if line == "" {
+				break
+			}
+
+			switch line[:1] {
+			case " ":
+				if newLine == lineNumber {
+					return oldLine, false
+				}
+				oldLine++
+				newLine++
+			case "-":
+				oldLine++
+			case "+":
+				if newLine == lineNumber {
+					return oldLine, true
+				}
+				newLine++
+			}
+		}
+
+		offset = oldLine - newLine
+	}
+
+	return lineNumber + offset, false
+}
+
+func (hunk *PatchHunk) hasOldLines() bool {
+	for _, line := range hunk.bodyLines {
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "-") {
+			return true
+		}
+	}
+
+	return false
+}
+
 func nLinesWithPrefix(lines []string, chars []string) int {
 	result := 0
 	for _, line := range lines {
@@ -546,3 +546,165 @@ func TestLineNumberOfLine(t *testing.T) {
 		})
 	}
 }
+
+func TestOldLineNumberOfLine(t *testing.T) {
+	type scenario struct {
+		testName string
+		hunk     *PatchHunk
+		idx      int
+		expected int
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "removed line",
+			hunk:     newHunk(strings.SplitAfter(exampleHunk, "\n"), 10),
+			idx:      12,
+			expected: 2,
+		},
+		{
+			testName: "line after removed line",
+			hunk:     newHunk(strings.SplitAfter(exampleHunk, "\n"), 10),
+			idx:      14,
+			expected: 3,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			result := s.hunk.OldLineNumberOfLine(s.idx)
+			if !assert.Equal(t, s.expected, result) {
+				fmt.Println(result)
+			}
+		})
+	}
+}
+
+// TestRemovedLineNumbers is a function.
+func TestRemovedLineNumbers(t *testing.T) {
+	type scenario struct {
+		testName string
+		hunk     string
+		expected []int
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "hunk with context",
+			hunk:     exampleHunk,
+			expected: []int{2},
+		},
+		{
+			testName: "hunk without context",
+			hunk:     "@@ -4,2 +4 @@\n-grape\n-pear\n+orange\n",
+			expected: []int{4, 5},
+		},
+		{
+			testName: "hunk that only adds lines",
+			hunk:     "@@ -3,0 +4,2 @@\n+orange\n+pear\n",
+			expected: []int{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			hunk := newHunk(strings.SplitAfter(s.hunk, "\n"), 0)
+			assert.EqualValues(t, s.expected, hunk.RemovedLineNumbers())
+		})
+	}
+}
+
+// TestOldLineNumberRange is a function.
+func TestOldLineNumberRange(t *testing.T) {
+	diff := `diff --git a/fruit.txt b/fruit.txt
+index 1234567..89abcde 100644
+--- a/fruit.txt
++++ b/fruit.txt
+@@ -2,3 +2,4 @@
+ two
+-three
++THREE
++extra
+ four
+@@ -8,2 +9,2 @@
+ eight
+-nine
++NINE
+`
+
+	type scenario struct {
+		testName          string
+		diff              string
+		firstLine         int
+		lastLine          int
+		expectedFirstLine int
+		expectedLastLine  int
+		expectedOk        bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:          "lines before any hunk",
+			diff:              diff,
+			firstLine:         1,
+			lastLine:          1,
+			expectedFirstLine: 1,
+			expectedLastLine:  1,
+			expectedOk:        true,
+		},
+		{
+			testName:          "range spanning added lines",
+			diff:              diff,
+			firstLine:         2,
+			lastLine:          5,
+			expectedFirstLine: 2,
+			expectedLastLine:  4,
+			expectedOk:        true,
+		},
+		{
+			testName:          "range starting on an added line and ending between hunks",
+			diff:              diff,
+			firstLine:         3,
+			lastLine:          6,
+			expectedFirstLine: 4,
+			expectedLastLine:  5,
+			expectedOk:        true,
+		},
+		{
+			testName:          "range ending on an added line",
+			diff:              diff,
+			firstLine:         9,
+			lastLine:          10,
+			expectedFirstLine: 8,
+			expectedLastLine:  9,
+			expectedOk:        true,
+		},
+		{
+			testName:          "only added lines",
+			diff:              diff,
+			firstLine:         3,
+			lastLine:          4,
+			expectedFirstLine: 4,
+			expectedLastLine:  3,
+			expectedOk:        false,
+		},
+		{
+			testName:          "new file",
+			diff:              "@@ -0,0 +1,2 @@\n+apple\n+orange\n",
+			firstLine:         1,
+			lastLine:          2,
+			expectedFirstLine: 1,
+			expectedLastLine:  0,
+			expectedOk:        false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			firstLine, lastLine, ok := OldLineNumberRange(s.diff, s.firstLine, s.lastLine)
+			assert.Equal(t, s.expectedFirstLine, firstLine)
+			assert.Equal(t, s.expectedLastLine, lastLine)
+			assert.Equal(t, s.expectedOk, ok)
+		})
+	}
+}
@@ -76,14 +76,19 @@ func (c *GitCommand) MovePatchToSelectedCommit(commits []*models.Commit, sourceC
 	}
 
 	baseIndex := sourceCommitIdx + 1
-	todo := ""
-	for i, commit := range commits[0:baseIndex] {
-		a := "pick"
+	branchHeads, err := c.getBranchHeadsForRebase()
+	if err != nil {
+		return err
+	}
+
+	actions := make([]string, baseIndex)
+	for i := range actions {
+		actions[i] = "pick"
 		if i == sourceCommitIdx || i == destinationCommitIdx {
-			a = "edit"
+			actions[i] = "edit"
 		}
-		todo = a + " " + commit.Sha + " " + commit.Name + "\n" + todo
 	}
+	todo := rebaseTodo(commits[0:baseIndex], actions, branchHeads)
 
 	cmd, err := c.PrepareInteractiveRebaseCommand(commits[baseIndex].Sha, todo, true)
 	if err != nil {
@@ -2,7 +2,10 @@ package commands
 
 import (
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -13,6 +16,20 @@ import (
 type Service struct {
 	Name           string
 	PullRequestURL string
+	// templates for URLs in the service's web UI, each of which takes the repo's
+	// owner and name, followed by:
+	// the commit sha
+	CommitURL string
+	// the commit sha and the file's path
+	FileURL string
+	// the commit sha, the file's path, and the first and last line numbers
+	FileLinesURL string
+	// the tag name
+	TagURL string
+	// the type of service, e.g. 'github', which tells us how to talk to its API
+	Type string
+	// the root of the service's REST API, if we support it
+	APIURL string
 }
 
 // PullRequest opens a link in browser to create new pull request
@@ -20,6 +37,7 @@ type Service struct {
 type PullRequest struct {
 	GitServices []*Service
 	GitCommand  *GitCommand
+	HTTPClient  *http.Client
 }
 
 // RepoInformation holds some basic information about the repo
@@ -30,26 +48,87 @@ type RepoInformation struct {
 
 // NewService builds a Service based on the host type
 func NewService(typeName string, repositoryDomain string, siteDomain string) *Service {
+	siteURL := func(path string) string {
+		return fmt.Sprintf("https://%s%s", siteDomain, path)
+	}
+
 	var service *Service
 
 	switch typeName {
 	case "github":
 		service = &Service{
 			Name:           repositoryDomain,
-			PullRequestURL: fmt.Sprintf("https://%s%s", siteDomain, "/%s/%s/compare/%s?expand=1"),
+			PullRequestURL: siteURL("/%s/%s/compare/%s?expand=1"),
+			CommitURL:      siteURL("/%s/%s/commit/%s"),
+			FileURL:        siteURL("/%s/%s/blob/%s/%s"),
+			FileLinesURL:   siteURL("/%s/%s/blob/%s/%s#L%d-L%d"),
+			TagURL:         siteURL("/%s/%s/releases/tag/%s"),
 		}
 	case "bitbucket":
 		service = &Service{
 			Name:           repositoryDomain,
-			PullRequestURL: fmt.Sprintf("https://%s%s", siteDomain, "/%s/%s/pull-requests/new?source=%s&t=1"),
+			PullRequestURL: siteURL("/%s/%s/pull-requests/new?source=%s&t=1"),
+			CommitURL:      siteURL("/%s/%s/commits/%s"),
+			FileURL:        siteURL("/%s/%s/src/%s/%s"),
+			FileLinesURL:   siteURL("/%s/%s/src/%s/%s#lines-%d:%d"),
+			TagURL:         siteURL("/%s/%s/src/%s"),
 		}
 	case "gitlab":
 		service = &Service{
 			Name:           repositoryDomain,
-			PullRequestURL: fmt.Sprintf("https://%s%s", siteDomain, "/%s/%s/merge_requests/new?merge_request[source_branch]=%s"),
+			PullRequestURL: siteURL("/%s/%s/merge_requests/new?merge_request[source_branch]=%s"),
+			CommitURL:      siteURL("/%s/%s/-/commit/%s"),
+			FileURL:        siteURL("/%s/%s/-/blob/%s/%s"),
+			FileLinesURL:   siteURL("/%s/%s/-/blob/%s/%s#L%d-%d"),
+			TagURL:         siteURL("/%s/%s/-/tags/%s"),
+		}
+	case "gitea", "forgejo":
+		service = &Service{
+			Name:           repositoryDomain,
+			PullRequestURL: siteURL("/%s/%s/compare/%s"),
+			CommitURL:      siteURL("/%s/%s/commit/%s"),
+			FileURL:        siteURL("/%s/%s/src/commit/%s/%s"),
+			FileLinesURL:   siteURL("/%s/%s/src/commit/%s/%s#L%d-L%d"),
+			TagURL:         siteURL("/%s/%s/releases/tag/%s"),
+		}
+	case "azuredevops":
+		// the owner is the organisation and project
+		service = &Service{
+			Name:           repositoryDomain,
+			PullRequestURL: siteURL("/%s/_git/%s/pullrequestcreate?sourceRef=%s"),
+			CommitURL:      siteURL("/%s/_git/%s/commit/%s"),
+			FileURL:        siteURL("/%s/_git/%s?version=GC%s&path=/%s"),
+			FileLinesURL:   siteURL("/%s/_git/%s?version=GC%s&path=/%s&line=%d&lineEnd=%d&lineStartColumn=1&lineEndColumn=1"),
+			TagURL:         siteURL("/%s/_git/%s?version=GT%s"),
+		}
+	case "bitbucketServer":
+		// the owner is the project key
+		service = &Service{
+			Name:           repositoryDomain,
+			PullRequestURL: siteURL("/projects/%s/repos/%s/pull-requests?create&sourceBranch=%s"),
+			CommitURL:      siteURL("/projects/%s/repos/%s/commits/%s"),
+			FileURL:        siteURL("/projects/%s/repos/%s/browse/%[4]s?at=%[3]s"),
+			FileLinesURL:   siteURL("/projects/%s/repos/%s/browse/%[4]s?at=%[3]s#%[5]d-%[6]d"),
+			TagURL:         siteURL("/projects/%s/repos/%s/browse?at=refs/tags/%s"),
+		}
+	case "codecommit":
+		// the owner is the region, which the console wants as a query param.
+		// The console can't link to lines, so we link to the file instead.
+		service = &Service{
+			Name:           repositoryDomain,
+			PullRequestURL: siteURL("/codesuite/codecommit/repositories/%[2]s/pull-requests/new/refs/heads/%[3]s?region=%[1]s"),
+			CommitURL:      siteURL("/codesuite/codecommit/repositories/%[2]s/commit/%[3]s?region=%[1]s"),
+			FileURL:        siteURL("/codesuite/codecommit/repositories/%[2]s/browse/%[3]s/--/%[4]s?region=%[1]s"),
+			FileLinesURL:   siteURL("/codesuite/codecommit/repositories/%[2]s/browse/%[3]s/--/%[4]s?region=%[1]s"),
+			TagURL:         siteURL("/codesuite/codecommit/repositories/%[2]s/browse/refs/tags/%[3]s/--/?region=%[1]s"),
 		}
 	}
 
+	if service != nil {
+		service.Type = typeName
+		service.APIURL = getForgeAPIURL(typeName, siteDomain)
+	}
+
 	return service
 }
 
@@ -58,6 +137,13 @@ func getServices(config config.AppConfigurer) []*Service {
 		NewService("github", "github.com", "github.com"),
 		NewService("bitbucket", "bitbucket.org", "bitbucket.org"),
 		NewService("gitlab", "gitlab.com", "gitlab.com"),
+		NewService("forgejo", "codeberg.org", "codeberg.org"),
+		NewService("azuredevops", "dev.azure.com", "dev.azure.com"),
+		// the old visualstudio.com URLs redirect to dev.azure.com
+		NewService("azuredevops", "visualstudio.com", "dev.azure.com"),
+		NewService("codecommit", "git-codecommit.", "console.aws.amazon.com"),
+		// git-remote-codecommit URLs
+		NewService("codecommit", "codecommit:", "console.aws.amazon.com"),
 	}
 
 	configServices := config.GetUserConfig().Services
@@ -86,6 +172,7 @@ func NewPullRequest(gitCommand *GitCommand) *PullRequest {
 	return &PullRequest{
 		GitServices: getServices(gitCommand.Config),
 		GitCommand:  gitCommand,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
 	}
 }
 
@@ -116,49 +203,156 @@ func (pr *PullRequest) getPullRequestURL(branch *models.Branch) (string, error)
 		return "", errors.New(pr.GitCommand.Tr.NoBranchOnRemote)
 	}
 
-	repoURL := pr.GitCommand.GetRemoteURL()
-	var gitService *Service
+	return pr.getWebURL(func(service *Service) string { return service.PullRequestURL }, branch.Name)
+}
 
+func (pr *PullRequest) getService(repoURL string) *Service {
 	for _, service := range pr.GitServices {
 		if strings.Contains(repoURL, service.Name) {
-			gitService = service
-			break
+			return service
 		}
 	}
 
-	if gitService == nil {
-		return "", errors.New(pr.GitCommand.Tr.UnsupportedGitService)
+	return nil
+}
+
+// GetOpenPullRequests asks the API of the service that the origin remote is
+// hosted on for the repo's open pull requests from the given branches, keyed by
+// source branch
+func (pr *PullRequest) GetOpenPullRequests(branchNames []string) (map[string]*models.PullRequest, error) {
+	repoURL := pr.GitCommand.GetRemoteURL()
+	gitService := pr.getService(repoURL)
+	if gitService == nil || gitService.APIURL == "" {
+		return nil, errors.New(pr.GitCommand.Tr.UnsupportedGitService)
+	}
+
+	token, err := pr.getAPIToken(gitService)
+	if err != nil {
+		return nil, err
 	}
 
+	client := NewForgeClient(gitService.Type, gitService.APIURL, token, pr.HTTPClient)
 	repoInfo := getRepoInfoFromURL(repoURL)
-	pullRequestURL := fmt.Sprintf(
-		gitService.PullRequestURL, repoInfo.Owner, repoInfo.Repository, branch.Name,
-	)
 
-	return pullRequestURL, nil
+	return client.GetOpenPullRequests(repoInfo.Owner, repoInfo.Repository, branchNames)
+}
+
+// getAPIToken runs the token command configured for the service's domain if
+// there is one, and otherwise falls back to e.g. $GITHUB_TOKEN. No token means
+// we make anonymous requests, which is enough for public repos.
+func (pr *PullRequest) getAPIToken(service *Service) (string, error) {
+	tokenCommand := pr.GitCommand.Config.GetUserConfig().Git.PullRequestStatus.TokenCommands[service.Name]
+	if tokenCommand == "" {
+		return os.Getenv(strings.ToUpper(service.Type) + "_TOKEN"), nil
+	}
+
+	osCommand := pr.GitCommand.OSCommand
+	token, err := osCommand.RunExecutableWithOutput(osCommand.ShellCommandFromString(tokenCommand))
+	return strings.TrimSpace(token), err
 }
 
+// getRepoInfoFromURL works out the owner and name of the repo from its remote
+// URL, be it HTTPS, SSH, or scp-like (git@host:owner/repo). The owner can have
+// several parts, e.g. for nested GitLab groups or Azure DevOps' org/project.
 func getRepoInfoFromURL(url string) *RepoInformation {
-	isHTTP := strings.HasPrefix(url, "http")
+	host, path := splitRemoteURL(url)
+	segments := strings.Split(path, "/")
 
-	if isHTTP {
-		splits := strings.Split(url, "/")
-		owner := strings.Join(splits[3:len(splits)-1], "/")
-		repo := strings.TrimSuffix(splits[len(splits)-1], ".git")
+	switch {
+	case strings.HasPrefix(url, "codecommit:"):
+		// git-remote-codecommit URLs, e.g. codecommit::us-east-1://profile@repo,
+		// where the region is left out if it's the AWS profile's default one
+		scheme := strings.SplitN(url, "://", 2)[0]
+		return &RepoInformation{
+			Owner:      strings.TrimPrefix(strings.TrimPrefix(scheme, "codecommit"), "::"),
+			Repository: host,
+		}
+
+	case strings.HasPrefix(host, "git-codecommit."):
+		// git-codecommit.<region>.amazonaws.com/v1/repos/<repo>
+		return &RepoInformation{
+			Owner:      strings.Split(host, ".")[1],
+			Repository: segments[len(segments)-1],
+		}
+
+	case strings.Contains(host, "dev.azure.com") || strings.Contains(host, "visualstudio.com"):
+		return getAzureRepoInfo(host, segments)
+
+	case len(segments) == 3 && segments[0] == "scm":
+		// Bitbucket Server's HTTPS URLs look like /scm/<project>/<repo>
+		segments = segments[1:]
+	}
 
+	return &RepoInformation{
+		Owner:      strings.Join(segments[0:len(segments)-1], "/"),
+		Repository: segments[len(segments)-1],
+	}
+}
+
+// getAzureRepoInfo handles Azure DevOps' URLs, where the owner is made up of the
+// organisation and the project:
+// https://dev.azure.com/<org>/<project>/_git/<repo>
+// https://<org>.visualstudio.com/[DefaultCollection/]<project>/_git/<repo>
+// git@ssh.dev.azure.com:v3/<org>/<project>/<repo>
+// <org>@vs-ssh.visualstudio.com:v3/<org>/<project>/<repo>
+func getAzureRepoInfo(host string, segments []string) *RepoInformation {
+	if segments[0] == "v3" {
 		return &RepoInformation{
-			Owner:      owner,
-			Repository: repo,
+			Owner:      strings.Join(segments[1:len(segments)-1], "/"),
+			Repository: segments[len(segments)-1],
+		}
+	}
+
+	ownerSegments := []string{}
+	repo := segments[len(segments)-1]
+	for i, segment := range segments {
+		if segment == "_git" {
+			repo = segments[i+1]
+			break
+		}
+		if segment != "DefaultCollection" {
+			ownerSegments = append(ownerSegments, segment)
 		}
 	}
 
-	tmpSplit := strings.Split(url, ":")
-	splits := strings.Split(tmpSplit[1], "/")
-	owner := strings.Join(splits[0:len(splits)-1], "/")
-	repo := strings.TrimSuffix(splits[len(splits)-1], ".git")
+	if strings.HasSuffix(host, ".visualstudio.com") {
+		org := strings.TrimSuffix(host, ".visualstudio.com")
+		ownerSegments = append([]string{org}, ownerSegments...)
+	}
 
 	return &RepoInformation{
-		Owner:      owner,
+		Owner:      strings.Join(ownerSegments, "/"),
 		Repository: repo,
 	}
 }
+
+// splitRemoteURL splits a remote URL into its host (without any user or port)
+// and its path (without leading or trailing slashes, or a .git suffix)
+func splitRemoteURL(url string) (string, string) {
+	var host, path string
+	if i := strings.Index(url, "://"); i != -1 {
+		rest := url[i+len("://"):]
+		host = rest
+		if j := strings.Index(rest, "/"); j != -1 {
+			host, path = rest[:j], rest[j+1:]
+		}
+		if k := strings.LastIndex(host, ":"); k != -1 {
+			host = host[:k]
+		}
+	} else {
+		// scp-like syntax, e.g. git@github.com:owner/repo.git
+		splitData := strings.SplitN(url, ":", 2)
+		host = splitData[0]
+		if len(splitData) == 2 {
+			path = splitData[1]
+		}
+	}
+
+	if i := strings.LastIndex(host, "@"); i != -1 {
+		host = host[i+1:]
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+
+	return host, path
+}
@@ -35,6 +35,86 @@ func TestGetRepoInfoFromURL(t *testing.T) {
 				assert.EqualValues(t, repoInfo.Repository, "social_network")
 			},
 		},
+		{
+			"Returns repository information for nested gitlab groups",
+			"git@gitlab.com:group/subgroup/calculator.git",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "group/subgroup")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
+		{
+			"Returns repository information for ssh remote url with a port",
+			"ssh://git@bitbucket.work.com:7999/proj/social_network.git",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "proj")
+				assert.EqualValues(t, repoInfo.Repository, "social_network")
+			},
+		},
+		{
+			"Returns repository information for bitbucket server http remote url",
+			"https://bitbucket.work.com/scm/proj/social_network.git",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "proj")
+				assert.EqualValues(t, repoInfo.Repository, "social_network")
+			},
+		},
+		{
+			"Returns repository information for azure devops http remote url",
+			"https://myorg@dev.azure.com/myorg/myproject/_git/calculator",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "myorg/myproject")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
+		{
+			"Returns repository information for azure devops ssh remote url",
+			"git@ssh.dev.azure.com:v3/myorg/myproject/calculator",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "myorg/myproject")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
+		{
+			"Returns repository information for visualstudio.com http remote url",
+			"https://myorg.visualstudio.com/DefaultCollection/myproject/_git/calculator",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "myorg/myproject")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
+		{
+			"Returns repository information for visualstudio.com ssh remote url",
+			"myorg@vs-ssh.visualstudio.com:v3/myorg/myproject/calculator",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "myorg/myproject")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
+		{
+			"Returns repository information for codecommit http remote url",
+			"https://git-codecommit.eu-west-1.amazonaws.com/v1/repos/calculator",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "eu-west-1")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
+		{
+			"Returns repository information for codecommit ssh remote url",
+			"ssh://git-codecommit.eu-west-1.amazonaws.com/v1/repos/calculator",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "eu-west-1")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
+		{
+			"Returns repository information for git-remote-codecommit url",
+			"codecommit::eu-west-1://work@calculator",
+			func(repoInfo *RepoInformation) {
+				assert.EqualValues(t, repoInfo.Owner, "eu-west-1")
+				assert.EqualValues(t, repoInfo.Repository, "calculator")
+			},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -139,6 +219,132 @@ func TestCreatePullRequest(t *testing.T) {
 				assert.Equal(t, "https://gitlab.com/peter/calculator/merge_requests/new?merge_request[source_branch]=feature/ui", url)
 			},
 		},
+		{
+			testName: "Opens a link to new pull request on azure devops",
+			branch: &models.Branch{
+				Name: "feature/ui",
+			},
+			remoteUrl: "https://myorg@dev.azure.com/myorg/myproject/_git/calculator",
+			command: func(cmd string, args ...string) *exec.Cmd {
+				// Handle git remote url call
+				if strings.HasPrefix(cmd, "git") {
+					return secureexec.Command("echo", "https://myorg@dev.azure.com/myorg/myproject/_git/calculator")
+				}
+
+				assert.Equal(t, cmd, "open")
+				assert.Equal(t, args, []string{"https://dev.azure.com/myorg/myproject/_git/calculator/pullrequestcreate?sourceRef=feature/ui"})
+				return secureexec.Command("echo")
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://dev.azure.com/myorg/myproject/_git/calculator/pullrequestcreate?sourceRef=feature/ui", url)
+			},
+		},
+		{
+			testName: "Opens a link to new pull request on azure devops with visualstudio.com remote url",
+			branch: &models.Branch{
+				Name: "feature/ui",
+			},
+			remoteUrl: "myorg@vs-ssh.visualstudio.com:v3/myorg/myproject/calculator",
+			command: func(cmd string, args ...string) *exec.Cmd {
+				// Handle git remote url call
+				if strings.HasPrefix(cmd, "git") {
+					return secureexec.Command("echo", "myorg@vs-ssh.visualstudio.com:v3/myorg/myproject/calculator")
+				}
+
+				assert.Equal(t, cmd, "open")
+				assert.Equal(t, args, []string{"https://dev.azure.com/myorg/myproject/_git/calculator/pullrequestcreate?sourceRef=feature/ui"})
+				return secureexec.Command("echo")
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://dev.azure.com/myorg/myproject/_git/calculator/pullrequestcreate?sourceRef=feature/ui", url)
+			},
+		},
+		{
+			testName: "Opens a link to new pull request on codecommit",
+			branch: &models.Branch{
+				Name: "feature/ui",
+			},
+			remoteUrl: "https://git-codecommit.eu-west-1.amazonaws.com/v1/repos/calculator",
+			command: func(cmd string, args ...string) *exec.Cmd {
+				// Handle git remote url call
+				if strings.HasPrefix(cmd, "git") {
+					return secureexec.Command("echo", "https://git-codecommit.eu-west-1.amazonaws.com/v1/repos/calculator")
+				}
+
+				assert.Equal(t, cmd, "open")
+				assert.Equal(t, args, []string{"https://console.aws.amazon.com/codesuite/codecommit/repositories/calculator/pull-requests/new/refs/heads/feature/ui?region=eu-west-1"})
+				return secureexec.Command("echo")
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://console.aws.amazon.com/codesuite/codecommit/repositories/calculator/pull-requests/new/refs/heads/feature/ui?region=eu-west-1", url)
+			},
+		},
+		{
+			testName: "Opens a link to new pull request on codeberg",
+			branch: &models.Branch{
+				Name: "feature/ui",
+			},
+			remoteUrl: "git@codeberg.org:peter/calculator.git",
+			command: func(cmd string, args ...string) *exec.Cmd {
+				// Handle git remote url call
+				if strings.HasPrefix(cmd, "git") {
+					return secureexec.Command("echo", "git@codeberg.org:peter/calculator.git")
+				}
+
+				assert.Equal(t, cmd, "open")
+				assert.Equal(t, args, []string{"https://codeberg.org/peter/calculator/compare/feature/ui"})
+				return secureexec.Command("echo")
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://codeberg.org/peter/calculator/compare/feature/ui", url)
+			},
+		},
+		{
+			testName: "Opens a link to new pull request on a custom bitbucket server",
+			branch: &models.Branch{
+				Name: "feature/ui",
+			},
+			remoteUrl: "ssh://git@bitbucket.work.com:7999/proj/calculator.git",
+			command: func(cmd string, args ...string) *exec.Cmd {
+				// Handle git remote url call
+				if strings.HasPrefix(cmd, "git") {
+					return secureexec.Command("echo", "ssh://git@bitbucket.work.com:7999/proj/calculator.git")
+				}
+
+				assert.Equal(t, cmd, "open")
+				assert.Equal(t, args, []string{"https://code.bitbucket.work.com/projects/proj/repos/calculator/pull-requests?create&sourceBranch=feature/ui"})
+				return secureexec.Command("echo")
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://code.bitbucket.work.com/projects/proj/repos/calculator/pull-requests?create&sourceBranch=feature/ui", url)
+			},
+		},
+		{
+			testName: "Opens a link to new pull request on a custom gitea",
+			branch: &models.Branch{
+				Name: "feature/ui",
+			},
+			remoteUrl: "git@gitea.work.com:peter/calculator.git",
+			command: func(cmd string, args ...string) *exec.Cmd {
+				// Handle git remote url call
+				if strings.HasPrefix(cmd, "git") {
+					return secureexec.Command("echo", "git@gitea.work.com:peter/calculator.git")
+				}
+
+				assert.Equal(t, cmd, "open")
+				assert.Equal(t, args, []string{"https://code.gitea.work.com/peter/calculator/compare/feature/ui"})
+				return secureexec.Command("echo")
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://code.gitea.work.com/peter/calculator/compare/feature/ui", url)
+			},
+		},
 		{
 			testName: "Throws an error if git service is unsupported",
 			branch: &models.Branch{
@@ -159,9 +365,11 @@ func TestCreatePullRequest(t *testing.T) {
 			gitCommand := NewDummyGitCommand()
 			gitCommand.OSCommand.Command = s.command
 			gitCommand.OSCommand.Config.GetUserConfig().OS.OpenLinkCommand = "open {{link}}"
-			gitCommand.OSCommand.Config.GetUserConfig().Services = map[string]string{
+			gitCommand.Config.GetUserConfig().Services = map[string]string{
 				// valid configuration for a custom service URL
-				"git.work.com": "gitlab:code.work.com",
+				"git.work.com":       "gitlab:code.work.com",
+				"bitbucket.work.com": "bitbucketServer:code.bitbucket.work.com",
+				"gitea.work.com":     "gitea:code.gitea.work.com",
 				// invalid configurations for a custom service URL
 				"invalid.work.com":   "noservice:invalid.work.com",
 				"noservice.work.com": "noservice.work.com",
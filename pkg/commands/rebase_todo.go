@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// git allows each todo action to be abbreviated to its first letter
+var todoActionAbbreviations = map[string]string{
+	"p": "pick",
+	"r": "reword",
+	"e": "edit",
+	"s": "squash",
+	"f": "fixup",
+	"x": "exec",
+	"b": "break",
+	"d": "drop",
+	"l": "label",
+	"t": "reset",
+	"m": "merge",
+	"u": "update-ref",
+}
+
+// parseTodoLine turns an entry of the git-rebase-todo file into a commit. For
+// entries that don't pick a commit, e.g. exec lines, the commit's name holds
+// the rest of the line. Examples:
+// pick ac446ae94ee560bdb8d1d057278657b251aaef17 blah commit on master
+// fixup -C afb893148791a2fbd8091aeb81deba4930c73031 fourth commit on master
+// exec make test
+// break
+// label onto
+// reset onto
+// merge -C 49cbba374296938ea86bbd4bf4fee2f6ba5cccf6 feature # Merge branch 'feature'
+// update-ref refs/heads/feature
+func parseTodoLine(line string) *models.Commit {
+	fields := strings.SplitN(strings.TrimSpace(line), " ", 2)
+	action := fields[0]
+	if fullAction, ok := todoActionAbbreviations[action]; ok {
+		action = fullAction
+	}
+
+	rest := ""
+	if len(fields) > 1 {
+		rest = strings.TrimSpace(fields[1])
+	}
+
+	commit := &models.Commit{
+		Status: "rebasing",
+		Action: action,
+	}
+
+	switch action {
+	case "pick", "reword", "edit", "squash", "fixup", "drop":
+		// fixup can be given -C or -c to use the commit's message
+		rest = trimTodoFlag(rest)
+		restFields := strings.SplitN(rest, " ", 2)
+		commit.Sha = restFields[0]
+		if len(restFields) > 1 {
+			commit.Name = restFields[1]
+		}
+	case "merge":
+		// a merge only refers to an existing commit if it's reusing that
+		// commit's message
+		if strings.HasPrefix(rest, "-C ") || strings.HasPrefix(rest, "-c ") {
+			restFields := strings.SplitN(rest, " ", 3)
+			commit.Sha = restFields[1]
+			if len(restFields) > 2 {
+				commit.Name = restFields[2]
+			}
+		} else {
+			commit.Name = rest
+		}
+	default:
+		commit.Name = rest
+	}
+
+	return commit
+}
+
+func trimTodoFlag(str string) string {
+	if strings.HasPrefix(str, "-C ") || strings.HasPrefix(str, "-c ") {
+		return strings.TrimSpace(str[3:])
+	}
+
+	return str
+}
+
+// todoLineIndices returns the indices of the lines in the git-rebase-todo file
+// that are actual entries, as opposed to blank lines, comments, or noops
+func todoLineIndices(lines []string) []int {
+	indices := []int{}
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "noop" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indices = append(indices, i)
+	}
+
+	return indices
+}
+
+// todoLineIndex returns the index of the line in the git-rebase-todo file for
+// the given todo index. Todos are indexed like commits in the commits panel,
+// with the most recent at the top, whereas the file has it at the bottom. The
+// index is -1 if there's no such todo.
+func todoLineIndex(lines []string, index int) int {
+	indices := todoLineIndices(lines)
+	if index < 0 || index > len(indices)-1 {
+		return -1
+	}
+
+	return indices[len(indices)-1-index]
+}
@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseTodoLine is a function.
+func TestParseTodoLine(t *testing.T) {
+	type scenario struct {
+		testName string
+		line     string
+		expected *models.Commit
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "pick",
+			line:     "pick ac446ae94ee560bdb8d1d057278657b251aaef17 blah commit on master",
+			expected: &models.Commit{Sha: "ac446ae94ee560bdb8d1d057278657b251aaef17", Name: "blah commit on master", Status: "rebasing", Action: "pick"},
+		},
+		{
+			testName: "abbreviated action",
+			line:     "f ac446ae94ee560bdb8d1d057278657b251aaef17 blah",
+			expected: &models.Commit{Sha: "ac446ae94ee560bdb8d1d057278657b251aaef17", Name: "blah", Status: "rebasing", Action: "fixup"},
+		},
+		{
+			testName: "fixup with flag",
+			line:     "fixup -C ac446ae94ee560bdb8d1d057278657b251aaef17 blah",
+			expected: &models.Commit{Sha: "ac446ae94ee560bdb8d1d057278657b251aaef17", Name: "blah", Status: "rebasing", Action: "fixup"},
+		},
+		{
+			testName: "exec",
+			line:     "exec make test",
+			expected: &models.Commit{Name: "make test", Status: "rebasing", Action: "exec"},
+		},
+		{
+			testName: "break",
+			line:     "break",
+			expected: &models.Commit{Status: "rebasing", Action: "break"},
+		},
+		{
+			testName: "label",
+			line:     "label onto",
+			expected: &models.Commit{Name: "onto", Status: "rebasing", Action: "label"},
+		},
+		{
+			testName: "merge reusing a commit message",
+			line:     "merge -C 49cbba374296938ea86bbd4bf4fee2f6ba5cccf6 feature # Merge branch 'feature'",
+			expected: &models.Commit{Sha: "49cbba374296938ea86bbd4bf4fee2f6ba5cccf6", Name: "feature # Merge branch 'feature'", Status: "rebasing", Action: "merge"},
+		},
+		{
+			testName: "merge without a commit",
+			line:     "merge feature",
+			expected: &models.Commit{Name: "feature", Status: "rebasing", Action: "merge"},
+		},
+		{
+			testName: "update-ref",
+			line:     "update-ref refs/heads/feature",
+			expected: &models.Commit{Name: "refs/heads/feature", Status: "rebasing", Action: "update-ref"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.EqualValues(t, s.expected, parseTodoLine(s.line))
+		})
+	}
+}
+
+// TestGitCommandEditRebaseTodoFile is a function.
+func TestGitCommandEditRebaseTodoFile(t *testing.T) {
+	todo := `pick aaa first
+exec make test
+pick bbb second
+
+# Rebase 123..bbb onto 123 (3 commands)
+`
+
+	type scenario struct {
+		testName string
+		edit     func(*GitCommand) error
+		expected string
+		test     func(error)
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "edit action",
+			edit:     func(gitCmd *GitCommand) error { return gitCmd.EditRebaseTodo(2, "drop") },
+			expected: "drop aaa first\nexec make test\npick bbb second\n\n# Rebase 123..bbb onto 123 (3 commands)\n",
+			test:     func(err error) { assert.NoError(t, err) },
+		},
+		{
+			testName: "edit action of exec line",
+			edit:     func(gitCmd *GitCommand) error { return gitCmd.EditRebaseTodo(1, "drop") },
+			expected: todo,
+			test:     func(err error) { assert.Error(t, err) },
+		},
+		{
+			testName: "move todo down",
+			edit:     func(gitCmd *GitCommand) error { return gitCmd.MoveTodoDown(1) },
+			expected: "exec make test\npick aaa first\npick bbb second\n\n# Rebase 123..bbb onto 123 (3 commands)\n",
+			test:     func(err error) { assert.NoError(t, err) },
+		},
+		{
+			testName: "insert above todo",
+			edit:     func(gitCmd *GitCommand) error { return gitCmd.InsertRebaseTodo(2, "break") },
+			expected: "pick aaa first\nbreak\nexec make test\npick bbb second\n\n# Rebase 123..bbb onto 123 (3 commands)\n",
+			test:     func(err error) { assert.NoError(t, err) },
+		},
+		{
+			testName: "insert above current commit",
+			edit:     func(gitCmd *GitCommand) error { return gitCmd.InsertRebaseTodo(3, "exec make lint") },
+			expected: "exec make lint\npick aaa first\nexec make test\npick bbb second\n\n# Rebase 123..bbb onto 123 (3 commands)\n",
+			test:     func(err error) { assert.NoError(t, err) },
+		},
+		{
+			testName: "insert out of range",
+			edit:     func(gitCmd *GitCommand) error { return gitCmd.InsertRebaseTodo(4, "break") },
+			expected: todo,
+			test:     func(err error) { assert.Error(t, err) },
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "lazygit-rebase-todo")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			assert.NoError(t, os.Mkdir(filepath.Join(dir, "rebase-merge"), 0755))
+			fileName := filepath.Join(dir, "rebase-merge/git-rebase-todo")
+			assert.NoError(t, ioutil.WriteFile(fileName, []byte(todo), 0644))
+
+			gitCmd := NewDummyGitCommand()
+			gitCmd.DotGitDir = dir
+
+			s.test(s.edit(gitCmd))
+
+			result, err := ioutil.ReadFile(fileName)
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expected, string(result))
+		})
+	}
+}
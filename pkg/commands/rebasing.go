@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/mgutz/str"
 )
 
@@ -23,28 +24,52 @@ func (c *GitCommand) RewordCommit(commits []*models.Commit, index int) (*exec.Cm
 }
 
 func (c *GitCommand) MoveCommitDown(commits []*models.Commit, index int) error {
+	todo, sha, err := c.GenerateMoveCommitDownTodo(commits, index)
+	if err != nil {
+		return err
+	}
+
+	cmd, err := c.PrepareInteractiveRebaseCommand(sha, todo, true)
+	if err != nil {
+		return err
+	}
+
+	return c.OSCommand.RunPreparedCommand(cmd)
+}
+
+// GenerateMoveCommitDownTodo returns the todo for a rebase which swaps the
+// commit at the index with the one below it, along with the sha to rebase onto
+func (c *GitCommand) GenerateMoveCommitDownTodo(commits []*models.Commit, index int) (string, string, error) {
 	// we must ensure that we have at least two commits after the selected one
 	if len(commits) <= index+2 {
 		// assuming they aren't picking the bottom commit
-		return errors.New(c.Tr.NoRoom)
+		return "", "", errors.New(c.Tr.NoRoom)
 	}
 
-	todo := ""
-	orderedCommits := append(commits[0:index], commits[index+1], commits[index])
-	for _, commit := range orderedCommits {
-		todo = "pick " + commit.Sha + " " + commit.Name + "\n" + todo
+	branchHeads, err := c.getBranchHeadsForRebase()
+	if err != nil {
+		return "", "", err
 	}
 
-	cmd, err := c.PrepareInteractiveRebaseCommand(commits[index+2].Sha, todo, true)
-	if err != nil {
-		return err
+	orderedCommits := make([]*models.Commit, 0, index+2)
+	orderedCommits = append(orderedCommits, commits[0:index]...)
+	orderedCommits = append(orderedCommits, commits[index+1], commits[index])
+	actions := make([]string, len(orderedCommits))
+	for i := range actions {
+		actions[i] = "pick"
 	}
 
-	return c.OSCommand.RunPreparedCommand(cmd)
+	return rebaseTodo(orderedCommits, actions, branchHeads), commits[index+2].Sha, nil
 }
 
 func (c *GitCommand) InteractiveRebase(commits []*models.Commit, index int, action string) error {
-	todo, sha, err := c.GenerateGenericRebaseTodo(commits, index, action)
+	return c.InteractiveRebaseRange(commits, index, index, action)
+}
+
+// InteractiveRebaseRange applies the action to each commit from startIndex to
+// endIndex inclusive, picking the rest
+func (c *GitCommand) InteractiveRebaseRange(commits []*models.Commit, startIndex int, endIndex int, action string) error {
+	todo, sha, err := c.GenerateRangeRebaseTodo(commits, startIndex, endIndex, action)
 	if err != nil {
 		return err
 	}
@@ -61,6 +86,12 @@ func (c *GitCommand) InteractiveRebase(commits []*models.Commit, index int, acti
 // we tell git to run lazygit to edit the todo list, and we pass the client
 // lazygit a todo string to write to the todo file
 func (c *GitCommand) PrepareInteractiveRebaseCommand(baseSha string, todo string, overrideEditor bool) (*exec.Cmd, error) {
+	return c.prepareInteractiveRebaseCommandWithArgs(baseSha, todo, overrideEditor)
+}
+
+// prepareInteractiveRebaseCommandWithArgs is like PrepareInteractiveRebaseCommand
+// but takes whatever arguments should follow 'git rebase', e.g. for an '--onto'
+func (c *GitCommand) prepareInteractiveRebaseCommandWithArgs(args string, todo string, overrideEditor bool) (*exec.Cmd, error) {
 	ex := c.OSCommand.GetLazygitPath()
 
 	debug := "FALSE"
@@ -68,7 +99,7 @@ func (c *GitCommand) PrepareInteractiveRebaseCommand(baseSha string, todo string
 		debug = "TRUE"
 	}
 
-	cmdStr := fmt.Sprintf("git rebase --interactive --autostash --keep-empty %s", baseSha)
+	cmdStr := fmt.Sprintf("git rebase --interactive --autostash --keep-empty%s %s", c.updateRefsFlag(), args)
 	c.Log.WithField("command", cmdStr).Info("RunCommand")
 	splitCmd := str.ToArgv(cmdStr)
 
@@ -100,7 +131,15 @@ func (c *GitCommand) PrepareInteractiveRebaseCommand(baseSha string, todo string
 }
 
 func (c *GitCommand) GenerateGenericRebaseTodo(commits []*models.Commit, actionIndex int, action string) (string, string, error) {
-	baseIndex := actionIndex + 1
+	return c.GenerateRangeRebaseTodo(commits, actionIndex, actionIndex, action)
+}
+
+// GenerateRangeRebaseTodo returns the todo for a rebase which applies the action
+// to each commit from startIndex to endIndex inclusive, along with the sha to
+// rebase onto. When squashing, the whole range is squashed into the commit
+// below it.
+func (c *GitCommand) GenerateRangeRebaseTodo(commits []*models.Commit, startIndex int, endIndex int, action string) (string, string, error) {
+	baseIndex := endIndex + 1
 
 	if len(commits) <= baseIndex {
 		return "", "", errors.New(c.Tr.CannotRebaseOntoFirstCommit)
@@ -114,23 +153,92 @@ func (c *GitCommand) GenerateGenericRebaseTodo(commits []*models.Commit, actionI
 		}
 	}
 
-	todo := ""
+	branchHeads, err := c.getBranchHeadsForRebase()
+	if err != nil {
+		return "", "", err
+	}
+
+	actions := make([]string, baseIndex)
 	for i, commit := range commits[0:baseIndex] {
-		var commitAction string
-		if i == actionIndex {
-			commitAction = action
+		if i >= startIndex && i <= endIndex {
+			actions[i] = action
 		} else if commit.IsMerge() {
 			// your typical interactive rebase will actually drop merge commits by default. Damn git CLI, you scary!
 			// doing this means we don't need to worry about rebasing over merges which always causes problems.
 			// you typically shouldn't be doing rebases that pass over merge commits anyway.
-			commitAction = "drop"
+			actions[i] = "drop"
 		} else {
-			commitAction = "pick"
+			actions[i] = "pick"
+		}
+	}
+
+	return rebaseTodo(commits[0:baseIndex], actions, branchHeads), commits[baseIndex].Sha, nil
+}
+
+// rebaseTodo returns the todo for rebasing the given commits, which are in the
+// same order as in the commits panel (newest first), with the given actions.
+// Because our todo replaces the one git generates, we have to add the
+// update-ref lines that --update-refs would have added ourselves: one after
+// each commit that's the head of a branch in branchHeads.
+func rebaseTodo(commits []*models.Commit, actions []string, branchHeads map[string][]string) string {
+	todo := ""
+	// update-ref lines are held back while a commit is being squashed into, so
+	// that the branch ends up at the squashed commit
+	pendingUpdateRefs := ""
+	for i := len(commits) - 1; i >= 0; i-- {
+		commit := commits[i]
+		if actions[i] != "squash" && actions[i] != "fixup" {
+			todo += pendingUpdateRefs
+			pendingUpdateRefs = ""
+		}
+		todo += actions[i] + " " + commit.Sha + " " + commit.Name + "\n"
+		for _, branchName := range branchHeads[commit.Sha] {
+			pendingUpdateRefs += "update-ref refs/heads/" + branchName + "\n"
+		}
+	}
+
+	return todo + pendingUpdateRefs
+}
+
+// getBranchHeadsForRebase returns the branch heads that a rebase should move
+// along with their commits, which is none unless updateRefs is on
+func (c *GitCommand) getBranchHeadsForRebase() (map[string][]string, error) {
+	if !c.Config.GetUserConfig().Git.UpdateRefs {
+		return map[string][]string{}, nil
+	}
+
+	return c.GetBranchHeadsToUpdate()
+}
+
+// GetBranchHeadsToUpdate maps commit shas to the names of the local branches
+// whose heads are at that commit, and which a rebase with --update-refs would
+// therefore move. Branches that are checked out, here or in another worktree,
+// aren't included because git won't move them.
+func (c *GitCommand) GetBranchHeadsToUpdate() (map[string][]string, error) {
+	format := "%(objectname) %(worktreepath) %(refname:short)"
+	output, err := c.OSCommand.RunCommandWithOutput("git for-each-ref --format=%s refs/heads/", c.OSCommand.Quote(format))
+	if err != nil {
+		return nil, err
+	}
+
+	branchHeads := map[string][]string{}
+	for _, line := range utils.SplitLines(output) {
+		split := strings.SplitN(line, " ", 3)
+		if len(split) < 3 || split[1] != "" {
+			continue
 		}
-		todo = commitAction + " " + commit.Sha + " " + commit.Name + "\n" + todo
+		branchHeads[split[0]] = append(branchHeads[split[0]], split[2])
+	}
+
+	return branchHeads, nil
+}
+
+func (c *GitCommand) updateRefsFlag() string {
+	if c.Config.GetUserConfig().Git.UpdateRefs {
+		return " --update-refs"
 	}
 
-	return todo, commits[baseIndex].Sha, nil
+	return ""
 }
 
 // AmendTo amends the given commit with whatever files are staged
@@ -142,6 +250,21 @@ func (c *GitCommand) AmendTo(sha string) error {
 	return c.SquashAllAboveFixupCommits(sha)
 }
 
+// GetRebaseTodo returns the contents of the git-rebase-todo file
+func (c *GitCommand) GetRebaseTodo() (string, error) {
+	bytes, err := ioutil.ReadFile(filepath.Join(c.DotGitDir, "rebase-merge/git-rebase-todo"))
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// SetRebaseTodo overwrites the git-rebase-todo file with the given contents
+func (c *GitCommand) SetRebaseTodo(todo string) error {
+	return ioutil.WriteFile(filepath.Join(c.DotGitDir, "rebase-merge/git-rebase-todo"), []byte(todo), 0644)
+}
+
 // EditRebaseTodo sets the action at a given index in the git-rebase-todo file
 func (c *GitCommand) EditRebaseTodo(index int, action string) error {
 	fileName := filepath.Join(c.DotGitDir, "rebase-merge/git-rebase-todo")
@@ -151,31 +274,50 @@ func (c *GitCommand) EditRebaseTodo(index int, action string) error {
 	}
 
 	content := strings.Split(string(bytes), "\n")
-	commitCount := c.getTodoCommitCount(content)
+	contentIndex := todoLineIndex(content, index)
+	if contentIndex == -1 {
+		return errors.New("index outside of range of rebase todos")
+	}
 
-	// we have the most recent commit at the bottom whereas the todo file has
-	// it at the bottom, so we need to subtract our index from the commit count
-	contentIndex := commitCount - 1 - index
-	splitLine := strings.Split(content[contentIndex], " ")
-	content[contentIndex] = action + " " + strings.Join(splitLine[1:], " ")
+	// only entries which pick a commit can have their action changed, and we
+	// rebuild the line so that any flags specific to the old action are dropped
+	todo := parseTodoLine(content[contentIndex])
+	if !models.IsCommitAction(todo.Action) {
+		return errors.New(c.Tr.CantChangeTodoCommandAction)
+	}
+	content[contentIndex] = action + " " + todo.Sha + " " + todo.Name
 	result := strings.Join(content, "\n")
 
 	return ioutil.WriteFile(fileName, []byte(result), 0644)
 }
 
-func (c *GitCommand) getTodoCommitCount(content []string) int {
-	// count lines that are not blank and are not comments
-	commitCount := 0
-	for _, line := range content {
-		if line != "" && !strings.HasPrefix(line, "#") {
-			commitCount++
-		}
+// MoveTodoDown moves a rebase todo item down by one position
+func (c *GitCommand) MoveTodoDown(index int) error {
+	fileName := filepath.Join(c.DotGitDir, "rebase-merge/git-rebase-todo")
+	bytes, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return err
 	}
-	return commitCount
+
+	content := strings.Split(string(bytes), "\n")
+	contentIndex := todoLineIndex(content, index)
+	// moving down in the commits panel means moving up in the file
+	prevContentIndex := todoLineIndex(content, index+1)
+	if contentIndex == -1 || prevContentIndex == -1 {
+		return errors.New("index outside of range of rebase todos")
+	}
+
+	content[contentIndex], content[prevContentIndex] = content[prevContentIndex], content[contentIndex]
+	result := strings.Join(content, "\n")
+
+	return ioutil.WriteFile(fileName, []byte(result), 0644)
 }
 
-// MoveTodoDown moves a rebase todo item down by one position
-func (c *GitCommand) MoveTodoDown(index int) error {
+// InsertRebaseTodo adds a line to the git-rebase-todo file just above the todo
+// at the given index, as the todos are shown in the commits panel, meaning it
+// will run right after that todo. An index one past the last todo refers to the
+// commit we're currently at, so the line is inserted as the next todo to run.
+func (c *GitCommand) InsertRebaseTodo(index int, todoLine string) error {
 	fileName := filepath.Join(c.DotGitDir, "rebase-merge/git-rebase-todo")
 	bytes, err := ioutil.ReadFile(fileName)
 	if err != nil {
@@ -183,12 +325,22 @@ func (c *GitCommand) MoveTodoDown(index int) error {
 	}
 
 	content := strings.Split(string(bytes), "\n")
-	commitCount := c.getTodoCommitCount(content)
-	contentIndex := commitCount - 1 - index
+	indices := todoLineIndices(content)
+
+	var insertIndex int
+	switch {
+	case index >= 0 && index < len(indices):
+		insertIndex = todoLineIndex(content, index) + 1
+	case index == len(indices) && len(indices) > 0:
+		insertIndex = indices[0]
+	case index == len(indices):
+		insertIndex = 0
+	default:
+		return errors.New("index outside of range of rebase todos")
+	}
 
-	rearrangedContent := append(content[0:contentIndex-1], content[contentIndex], content[contentIndex-1])
-	rearrangedContent = append(rearrangedContent, content[contentIndex+1:]...)
-	result := strings.Join(rearrangedContent, "\n")
+	content = append(content[:insertIndex], append([]string{todoLine}, content[insertIndex:]...)...)
+	result := strings.Join(content, "\n")
 
 	return ioutil.WriteFile(fileName, []byte(result), 0644)
 }
@@ -197,7 +349,8 @@ func (c *GitCommand) MoveTodoDown(index int) error {
 func (c *GitCommand) SquashAllAboveFixupCommits(sha string) error {
 	return c.runSkipEditorCommand(
 		fmt.Sprintf(
-			"git rebase --interactive --autostash --autosquash %s^",
+			"git rebase --interactive --autostash --autosquash%s %s^",
+			c.updateRefsFlag(),
 			sha,
 		),
 	)
@@ -234,6 +387,31 @@ func (c *GitCommand) BeginInteractiveRebaseForCommit(commits []*models.Commit, c
 	return nil
 }
 
+// BeginSplitCommit stops an interactive rebase at the given commit and undoes
+// it, leaving its changes in the working tree so that they can be committed
+// again in pieces. After this you'll want to commit each piece and then call
+// `c.GenericMergeOrRebaseAction("rebase", "continue")`
+func (c *GitCommand) BeginSplitCommit(commits []*models.Commit, commitIndex int) error {
+	if err := c.BeginInteractiveRebaseForCommit(commits, commitIndex); err != nil {
+		return err
+	}
+
+	// the soft reset undoes the commit itself, and then we unstage its changes
+	// so that each piece can be staged in turn. The -N means files added by the
+	// commit stay tracked, so that we can stage them a hunk at a time too
+	if err := c.ResetSoft("HEAD^"); err != nil {
+		_ = c.GenericMergeOrRebaseAction("rebase", "abort")
+		return err
+	}
+
+	if err := c.RunCommand("git reset --quiet -N"); err != nil {
+		_ = c.GenericMergeOrRebaseAction("rebase", "abort")
+		return err
+	}
+
+	return nil
+}
+
 // RebaseBranch interactive rebases onto a branch
 func (c *GitCommand) RebaseBranch(branchName string) error {
 	cmd, err := c.PrepareInteractiveRebaseCommand(branchName, "", false)
@@ -244,6 +422,28 @@ func (c *GitCommand) RebaseBranch(branchName string) error {
 	return c.OSCommand.RunPreparedCommand(cmd)
 }
 
+// RebaseOnto moves the commits after upstream, up to HEAD, onto newBase
+func (c *GitCommand) RebaseOnto(newBase string, upstream string) error {
+	args := fmt.Sprintf("--onto %s %s", c.OSCommand.Quote(newBase), c.OSCommand.Quote(upstream))
+	cmd, err := c.prepareInteractiveRebaseCommandWithArgs(args, "", false)
+	if err != nil {
+		return err
+	}
+
+	return c.OSCommand.RunPreparedCommand(cmd)
+}
+
+// GetRebaseOntoCommits returns the oneline descriptions of the commits that
+// rebasing onto a new base from the given upstream would move, most recent first
+func (c *GitCommand) GetRebaseOntoCommits(upstream string) ([]string, error) {
+	output, err := c.OSCommand.RunCommandWithOutput("git log --oneline --no-decorate --no-color %s..HEAD", c.OSCommand.Quote(upstream))
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
 // GenericMerge takes a commandType of "merge" or "rebase" and a command of "abort", "skip" or "continue"
 // By default we skip the editor in the case where a commit will be made
 func (c *GitCommand) GenericMergeOrRebaseAction(commandType string, command string) error {
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -57,6 +58,99 @@ func TestGitCommandRebaseBranch(t *testing.T) {
 	}
 }
 
+// TestGitCommandRebaseOnto is a function.
+func TestGitCommandRebaseOnto(t *testing.T) {
+	gitCmd := NewDummyGitCommand()
+	gitCmd.OSCommand.Command = test.CreateMockCommand(t, []*test.CommandSwapper{
+		{
+			Expect:  "git rebase --interactive --autostash --keep-empty --onto new-base old-base",
+			Replace: "echo",
+		},
+	})
+
+	assert.NoError(t, gitCmd.RebaseOnto("new-base", "old-base"))
+}
+
+// TestGitCommandGenerateRangeRebaseTodoWithUpdateRefs is a function.
+func TestGitCommandGenerateRangeRebaseTodoWithUpdateRefs(t *testing.T) {
+	commits := []*models.Commit{
+		{Sha: "a", Name: "commit a"},
+		{Sha: "b", Name: "commit b"},
+		{Sha: "c", Name: "commit c"},
+		{Sha: "d", Name: "commit d"},
+	}
+
+	type scenario struct {
+		testName     string
+		startIndex   int
+		endIndex     int
+		action       string
+		expectedTodo string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "branch heads are updated after their commit",
+			startIndex:   2,
+			endIndex:     2,
+			action:       "edit",
+			expectedTodo: "edit c commit c\nupdate-ref refs/heads/feature\npick b commit b\npick a commit a\n",
+		},
+		{
+			testName:     "branch heads are updated after squashing into their commit",
+			startIndex:   1,
+			endIndex:     1,
+			action:       "squash",
+			expectedTodo: "pick c commit c\nsquash b commit b\nupdate-ref refs/heads/feature\npick a commit a\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			gitCmd.Config.GetUserConfig().Git.UpdateRefs = true
+			gitCmd.OSCommand.Command = test.CreateMockCommand(t, []*test.CommandSwapper{
+				{
+					Expect:  `git for-each-ref --format="%(objectname) %(worktreepath) %(refname:short)" refs/heads/`,
+					Replace: `printf "c  feature\\na /path/to/worktree checked-out\\n"`,
+				},
+			})
+
+			todo, _, err := gitCmd.GenerateRangeRebaseTodo(commits, s.startIndex, s.endIndex, s.action)
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expectedTodo, todo)
+		})
+	}
+}
+
+// TestGitCommandGenerateMoveCommitDownTodoWithUpdateRefs is a function.
+func TestGitCommandGenerateMoveCommitDownTodoWithUpdateRefs(t *testing.T) {
+	commits := []*models.Commit{
+		{Sha: "a", Name: "commit a"},
+		{Sha: "b", Name: "commit b"},
+		{Sha: "c", Name: "commit c"},
+		{Sha: "d", Name: "commit d"},
+		{Sha: "e", Name: "commit e"},
+	}
+
+	gitCmd := NewDummyGitCommand()
+	gitCmd.Config.GetUserConfig().Git.UpdateRefs = true
+	gitCmd.OSCommand.Command = test.CreateMockCommand(t, []*test.CommandSwapper{
+		{
+			Expect:  `git for-each-ref --format="%(objectname) %(worktreepath) %(refname:short)" refs/heads/`,
+			Replace: `printf "b  feature\\na /path/to/worktree checked-out\\n"`,
+		},
+	})
+
+	todo, sha, err := gitCmd.GenerateMoveCommitDownTodo(commits, 2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "e", sha)
+	assert.EqualValues(t, "pick c commit c\npick d commit d\npick b commit b\nupdate-ref refs/heads/feature\npick a commit a\n", todo)
+	// the commits themselves are left alone
+	assert.EqualValues(t, "c", commits[2].Sha)
+	assert.EqualValues(t, "d", commits[3].Sha)
+}
+
 // TestGitCommandSkipEditorCommand confirms that SkipEditorCommand injects
 // environment variables that suppress an interactive editor
 func TestGitCommandSkipEditorCommand(t *testing.T) {
@@ -94,3 +188,80 @@ func TestGitCommandSkipEditorCommand(t *testing.T) {
 
 	_ = cmd.runSkipEditorCommand("true")
 }
+
+// TestGitCommandGenerateRangeRebaseTodo is a function.
+func TestGitCommandGenerateRangeRebaseTodo(t *testing.T) {
+	commits := []*models.Commit{
+		{Sha: "a", Name: "commit a"},
+		{Sha: "b", Name: "commit b"},
+		{Sha: "c", Name: "commit c"},
+		{Sha: "d", Name: "commit d"},
+	}
+
+	type scenario struct {
+		testName     string
+		startIndex   int
+		endIndex     int
+		action       string
+		expectedTodo string
+		expectedSha  string
+		expectedErr  bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "single commit",
+			startIndex:   1,
+			endIndex:     1,
+			action:       "drop",
+			expectedTodo: "drop b commit b\npick a commit a\n",
+			expectedSha:  "c",
+		},
+		{
+			testName:     "drop range",
+			startIndex:   0,
+			endIndex:     1,
+			action:       "drop",
+			expectedTodo: "drop b commit b\ndrop a commit a\n",
+			expectedSha:  "c",
+		},
+		{
+			testName:     "squash range into the commit below",
+			startIndex:   0,
+			endIndex:     1,
+			action:       "squash",
+			expectedTodo: "pick c commit c\nsquash b commit b\nsquash a commit a\n",
+			expectedSha:  "d",
+		},
+		{
+			testName:    "no commit to squash into",
+			startIndex:  1,
+			endIndex:    2,
+			action:      "fixup",
+			expectedErr: true,
+		},
+		{
+			testName:    "range includes the first commit",
+			startIndex:  2,
+			endIndex:    3,
+			action:      "drop",
+			expectedErr: true,
+		},
+	}
+
+	gitCmd := NewDummyGitCommand()
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			todo, sha, err := gitCmd.GenerateRangeRebaseTodo(commits, s.startIndex, s.endIndex, s.action)
+			if s.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expectedTodo, todo)
+			assert.EqualValues(t, s.expectedSha, sha)
+		})
+	}
+}
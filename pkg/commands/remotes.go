@@ -22,9 +22,9 @@ func (c *GitCommand) UpdateRemoteUrl(remoteName string, updatedUrl string) error
 	return c.RunCommand("git remote set-url %s %s", remoteName, updatedUrl)
 }
 
-func (c *GitCommand) DeleteRemoteBranch(remoteName string, branchName string, promptUserForCredential func(string) string) error {
+func (c *GitCommand) DeleteRemoteBranch(remoteName string, branchName string, promptUserForCredential func(string, string) string) error {
 	command := fmt.Sprintf("git push %s --delete %s", remoteName, branchName)
-	return c.OSCommand.DetectUnamePass(command, promptUserForCredential)
+	return c.OSCommand.RunCommandWithCredentials(command, c.getRemoteURL(remoteName), promptUserForCredential)
 }
 
 // CheckRemoteBranchExists Returns remote branch
@@ -0,0 +1,169 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// When the git.safetySnapshots config is on, we take a snapshot before any
+// action that would permanently destroy uncommitted work, like a hard reset.
+// Each snapshot is a pair of commits kept under a private ref:
+// refs/lazygit/snapshots/<unix timestamp>-<sha> points to a commit whose tree
+// holds the working tree, untracked files included, and whose parent holds the
+// index, which in turn has HEAD as its parent (if there is a HEAD). The sha is
+// there so that snapshots taken in the same second get their own refs. Only the
+// most recent snapshots are kept.
+
+const SAFETY_SNAPSHOTS_REF_PREFIX = "refs/lazygit/snapshots/"
+
+// how many snapshots we keep before deleting the oldest
+const MAX_SAFETY_SNAPSHOTS = 50
+
+type SafetySnapshot struct {
+	Ref  string
+	Sha  string
+	Time int64
+	// the action the snapshot was taken before
+	Name string
+}
+
+// CreateSafetySnapshot snapshots the index, working tree and untracked files,
+// labelling the snapshot with the action that's about to happen
+func (c *GitCommand) CreateSafetySnapshot(name string) error {
+	// if the index has conflicts we can't write it to a tree, in which case
+	// we fall back to using the working tree for the index
+	indexTree, indexErr := c.OSCommand.RunCommandWithOutput("git write-tree")
+
+	workingTree, err := c.writeWorkingTree()
+	if err != nil {
+		return err
+	}
+
+	if indexErr != nil {
+		indexTree = workingTree
+	}
+
+	parentArg := ""
+	if headSha, err := c.GetHeadSha(); err == nil {
+		parentArg = " -p " + headSha
+	}
+
+	indexCommit, err := c.OSCommand.RunCommandWithOutput(
+		"git commit-tree %s%s -m %s", strings.TrimSpace(indexTree), parentArg, c.OSCommand.Quote("index: "+name),
+	)
+	if err != nil {
+		return err
+	}
+
+	snapshotCommit, err := c.OSCommand.RunCommandWithOutput(
+		"git commit-tree %s -p %s -m %s", workingTree, strings.TrimSpace(indexCommit), c.OSCommand.Quote(name),
+	)
+	if err != nil {
+		return err
+	}
+
+	snapshotSha := strings.TrimSpace(snapshotCommit)
+	ref := fmt.Sprintf("%s%d-%s", SAFETY_SNAPSHOTS_REF_PREFIX, time.Now().Unix(), utils.SafeTruncate(snapshotSha, 10))
+	if err := c.RunCommand("git update-ref %s %s", ref, snapshotSha); err != nil {
+		return err
+	}
+
+	return c.pruneSafetySnapshots(MAX_SAFETY_SNAPSHOTS)
+}
+
+// pruneSafetySnapshots deletes all but the most recent snapshots
+func (c *GitCommand) pruneSafetySnapshots(keep int) error {
+	snapshots, err := c.GetSafetySnapshots()
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots[utils.Min(keep, len(snapshots)):] {
+		if err := c.RunCommand("git update-ref -d %s", snapshot.Ref); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeWorkingTree writes the working tree, including untracked files, to a
+// tree without touching the index, by staging everything into a copy of it
+func (c *GitCommand) writeWorkingTree() (string, error) {
+	tempIndex, err := ioutil.TempFile("", "lazygit-snapshot-index")
+	if err != nil {
+		return "", err
+	}
+	tempIndex.Close()
+	defer os.Remove(tempIndex.Name())
+
+	index, err := ioutil.ReadFile(filepath.Join(c.DotGitDir, "index"))
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	if err := ioutil.WriteFile(tempIndex.Name(), index, 0644); err != nil {
+		return "", err
+	}
+
+	options := oscommands.RunCommandOptions{EnvVars: []string{"GIT_INDEX_FILE=" + tempIndex.Name()}}
+	if _, err := c.OSCommand.RunCommandWithOutputWithOptions("git add --all", options); err != nil {
+		return "", err
+	}
+
+	tree, err := c.OSCommand.RunCommandWithOutputWithOptions("git write-tree", options)
+	return strings.TrimSpace(tree), err
+}
+
+// GetSafetySnapshots returns the safety snapshots, most recent first
+func (c *GitCommand) GetSafetySnapshots() ([]*SafetySnapshot, error) {
+	output, err := c.OSCommand.RunCommandWithOutput(
+		`git for-each-ref --sort=-refname --format="%%(refname) %%(objectname) %%(contents:subject)" %s`,
+		SAFETY_SNAPSHOTS_REF_PREFIX,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []*SafetySnapshot{}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) < 2 {
+			continue
+		}
+
+		timestampStr := strings.SplitN(strings.TrimPrefix(fields[0], SAFETY_SNAPSHOTS_REF_PREFIX), "-", 2)[0]
+		timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		snapshot := &SafetySnapshot{Ref: fields[0], Sha: fields[1], Time: timestamp}
+		if len(fields) > 2 {
+			snapshot.Name = fields[2]
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// RestoreSafetySnapshot sets the index and working tree to how they were in
+// the snapshot, leaving HEAD where it is. Untracked files that weren't around
+// when the snapshot was taken are left alone.
+func (c *GitCommand) RestoreSafetySnapshot(snapshot *SafetySnapshot) error {
+	// setting the index first means that files which were staged but deleted
+	// in the working tree are deleted again by the restore
+	if err := c.RunCommand("git read-tree %s^", snapshot.Sha); err != nil {
+		return err
+	}
+
+	return c.RunCommand("git restore --source=%s --worktree -- .", snapshot.Sha)
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitCommandGetSafetySnapshots is a function.
+func TestGitCommandGetSafetySnapshots(t *testing.T) {
+	type scenario struct {
+		testName string
+		command  func(string, ...string) *exec.Cmd
+		expected []*SafetySnapshot
+	}
+
+	expect := `git for-each-ref --sort=-refname --format="%(refname) %(objectname) %(contents:subject)" refs/lazygit/snapshots/`
+
+	scenarios := []scenario{
+		{
+			"no snapshots",
+			test.CreateMockCommand(t, []*test.CommandSwapper{
+				{
+					Expect:  expect,
+					Replace: "echo",
+				},
+			}),
+			[]*SafetySnapshot{},
+		},
+		{
+			"some snapshots",
+			test.CreateMockCommand(t, []*test.CommandSwapper{
+				{
+					Expect:  expect,
+					Replace: `printf "refs/lazygit/snapshots/1600000100-ccc ccc Clean\\nrefs/lazygit/snapshots/1600000100-bbb bbb Hard reset\\nrefs/lazygit/snapshots/1600000000-aaa aaa Discard all changes in file\\n"`,
+				},
+			}),
+			[]*SafetySnapshot{
+				{Ref: "refs/lazygit/snapshots/1600000100-ccc", Sha: "ccc", Time: 1600000100, Name: "Clean"},
+				{Ref: "refs/lazygit/snapshots/1600000100-bbb", Sha: "bbb", Time: 1600000100, Name: "Hard reset"},
+				{Ref: "refs/lazygit/snapshots/1600000000-aaa", Sha: "aaa", Time: 1600000000, Name: "Discard all changes in file"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			gitCmd.OSCommand.Command = s.command
+
+			snapshots, err := gitCmd.GetSafetySnapshots()
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expected, snapshots)
+		})
+	}
+}
+
+// TestGitCommandPruneSafetySnapshots is a function.
+func TestGitCommandPruneSafetySnapshots(t *testing.T) {
+	gitCmd := NewDummyGitCommand()
+	gitCmd.OSCommand.Command = test.CreateMockCommand(t, []*test.CommandSwapper{
+		{
+			Expect:  `git for-each-ref --sort=-refname --format="%(refname) %(objectname) %(contents:subject)" refs/lazygit/snapshots/`,
+			Replace: `printf "refs/lazygit/snapshots/1600000200-ccc ccc Clean\\nrefs/lazygit/snapshots/1600000100-bbb bbb Hard reset\\nrefs/lazygit/snapshots/1600000000-aaa aaa Discard all changes in file\\n"`,
+		},
+		{
+			Expect:  "git update-ref -d refs/lazygit/snapshots/1600000100-bbb",
+			Replace: "echo",
+		},
+		{
+			Expect:  "git update-ref -d refs/lazygit/snapshots/1600000000-aaa",
+			Replace: "echo",
+		},
+	})
+
+	assert.NoError(t, gitCmd.pruneSafetySnapshots(1))
+}
@@ -11,6 +11,7 @@ const (
 	REBASE_MODE_INTERACTIVE = "interactive"
 	REBASE_MODE_REBASING    = "rebasing"
 	REBASE_MODE_MERGING     = "merging"
+	REBASE_MODE_BISECTING   = "bisecting"
 )
 
 // RebaseMode returns "" for non-rebase mode, "normal" for normal rebase
@@ -40,6 +41,10 @@ func (c *GitCommand) WorkingTreeState() string {
 	if merging {
 		return REBASE_MODE_MERGING
 	}
+	bisecting, _ := c.IsInBisectState()
+	if bisecting {
+		return REBASE_MODE_BISECTING
+	}
 	return REBASE_MODE_NORMAL
 }
 
@@ -2,11 +2,14 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // Push pushes to a branch
-func (c *GitCommand) Push(branchName string, force bool, upstream string, args string, promptUserForCredential func(string) string) error {
+func (c *GitCommand) Push(branchName string, force bool, upstream string, args string, promptUserForCredential func(string, string) string) error {
 	followTagsFlag := "--follow-tags"
 	if c.GetConfigValue("push.followTags") == "false" {
 		followTagsFlag = ""
@@ -22,12 +25,51 @@ func (c *GitCommand) Push(branchName string, force bool, upstream string, args s
 		setUpstreamArg = "--set-upstream " + upstream
 	}
 
+	remoteName := c.GetConfigValue(fmt.Sprintf("branch.%s.remote", branchName))
+	if fields := strings.Fields(upstream); len(fields) == 2 {
+		remoteName = fields[0]
+	}
+
 	cmd := fmt.Sprintf("git push %s %s %s %s", followTagsFlag, forceFlag, setUpstreamArg, args)
-	return c.OSCommand.DetectUnamePass(cmd, promptUserForCredential)
+	return c.OSCommand.RunCommandWithCredentials(cmd, c.getRemoteURL(remoteName), promptUserForCredential)
+}
+
+// getRemoteURL returns the URL of the given remote, for keying the answers to
+// credential prompts by. Without a name we go with origin, which is what git
+// falls back to when a branch doesn't have a remote.
+func (c *GitCommand) getRemoteURL(remoteName string) string {
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
+	return c.GetConfigValue(fmt.Sprintf("remote.%s.url", remoteName))
+}
+
+// GetCommitsOverwrittenByForcePush returns the oneline descriptions of the
+// commits on the remote branch that force pushing the given branch would throw
+// away, i.e. those that aren't in the local branch, most recent first. The
+// upstream is given as '<remote> <branch>' if we're pushing somewhere other
+// than the branch's configured push destination.
+func (c *GitCommand) GetCommitsOverwrittenByForcePush(branchName string, upstream string) ([]string, error) {
+	remoteRef := branchName + "@{push}"
+	if fields := strings.Fields(upstream); len(fields) == 2 {
+		remoteRef = fields[0] + "/" + fields[1]
+	}
+
+	output, err := c.OSCommand.RunCommandWithOutput(
+		"git log --oneline --no-decorate --no-color %s..%s",
+		c.OSCommand.Quote(branchName),
+		c.OSCommand.Quote(remoteRef),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
 }
 
 type FetchOptions struct {
-	PromptUserForCredential func(string) string
+	PromptUserForCredential func(string, string) string
 	RemoteName              string
 	BranchName              string
 }
@@ -43,22 +85,22 @@ func (c *GitCommand) Fetch(opts FetchOptions) error {
 		command = fmt.Sprintf("%s %s", command, opts.BranchName)
 	}
 
-	return c.OSCommand.DetectUnamePass(command, func(question string) string {
+	return c.OSCommand.RunCommandWithCredentials(command, c.getRemoteURL(opts.RemoteName), func(remoteURL string, prompt string) string {
 		if opts.PromptUserForCredential != nil {
-			return opts.PromptUserForCredential(question)
+			return opts.PromptUserForCredential(remoteURL, prompt)
 		}
-		return "\n"
+		return ""
 	})
 }
 
-func (c *GitCommand) FastForward(branchName string, remoteName string, remoteBranchName string, promptUserForCredential func(string) string) error {
+func (c *GitCommand) FastForward(branchName string, remoteName string, remoteBranchName string, promptUserForCredential func(string, string) string) error {
 	command := fmt.Sprintf("git fetch %s %s:%s", remoteName, remoteBranchName, branchName)
-	return c.OSCommand.DetectUnamePass(command, promptUserForCredential)
+	return c.OSCommand.RunCommandWithCredentials(command, c.getRemoteURL(remoteName), promptUserForCredential)
 }
 
-func (c *GitCommand) FetchRemote(remoteName string, promptUserForCredential func(string) string) error {
+func (c *GitCommand) FetchRemote(remoteName string, promptUserForCredential func(string, string) string) error {
 	command := fmt.Sprintf("git fetch %s", remoteName)
-	return c.OSCommand.DetectUnamePass(command, promptUserForCredential)
+	return c.OSCommand.RunCommandWithCredentials(command, c.getRemoteURL(remoteName), promptUserForCredential)
 }
 
 func (c *GitCommand) GetPullMode(mode string) string {
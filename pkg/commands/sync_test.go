@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/secureexec"
+	"github.com/jesseduffield/lazygit/pkg/test"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -89,7 +90,7 @@ func TestGitCommandPush(t *testing.T) {
 			gitCmd := NewDummyGitCommand()
 			gitCmd.OSCommand.Command = s.command
 			gitCmd.getGitConfigValue = s.getGitConfigValue
-			err := gitCmd.Push("test", s.forcePush, "", "", func(passOrUname string) string {
+			err := gitCmd.Push("test", s.forcePush, "", "", func(remoteURL string, passOrUname string) string {
 				return "\n"
 			})
 			s.test(err)
@@ -97,6 +98,52 @@ func TestGitCommandPush(t *testing.T) {
 	}
 }
 
+// TestGitCommandGetCommitsOverwrittenByForcePush is a function.
+func TestGitCommandGetCommitsOverwrittenByForcePush(t *testing.T) {
+	type scenario struct {
+		testName string
+		upstream string
+		command  func(string, ...string) *exec.Cmd
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			"push destination",
+			"",
+			test.CreateMockCommand(t, []*test.CommandSwapper{
+				{
+					Expect:  "git log --oneline --no-decorate --no-color feature..feature@{push}",
+					Replace: `printf "bbb second\\naaa first\\n"`,
+				},
+			}),
+			[]string{"bbb second", "aaa first"},
+		},
+		{
+			"given upstream",
+			"origin other",
+			test.CreateMockCommand(t, []*test.CommandSwapper{
+				{
+					Expect:  "git log --oneline --no-decorate --no-color feature..origin/other",
+					Replace: "echo",
+				},
+			}),
+			[]string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			gitCmd.OSCommand.Command = s.command
+
+			commits, err := gitCmd.GetCommitsOverwrittenByForcePush("feature", s.upstream)
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expected, commits)
+		})
+	}
+}
+
 type getPullModeScenario struct {
 	testName              string
 	getGitConfigValueMock func(string) (string, error)
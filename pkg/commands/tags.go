@@ -6,11 +6,29 @@ func (c *GitCommand) CreateLightweightTag(tagName string, commitSha string) erro
 	return c.RunCommand("git tag %s %s", tagName, commitSha)
 }
 
+// CreateAnnotatedTagCmdStr returns the command for creating an annotated tag,
+// which is GPG-signed if sign is true. We return the command string rather
+// than running it so that the caller can hand it to a subprocess if GPG needs
+// to prompt for a passphrase.
+func (c *GitCommand) CreateAnnotatedTagCmdStr(tagName string, commitSha string, message string, sign bool) string {
+	flag := "-a"
+	if sign {
+		flag = "-s"
+	}
+
+	commitShaArg := ""
+	if commitSha != "" {
+		commitShaArg = " " + commitSha
+	}
+
+	return fmt.Sprintf("git tag %s %s -m %s%s", flag, c.OSCommand.Quote(tagName), c.OSCommand.Quote(message), commitShaArg)
+}
+
 func (c *GitCommand) DeleteTag(tagName string) error {
 	return c.RunCommand("git tag -d %s", tagName)
 }
 
-func (c *GitCommand) PushTag(remoteName string, tagName string, promptUserForCredential func(string) string) error {
+func (c *GitCommand) PushTag(remoteName string, tagName string, promptUserForCredential func(string, string) string) error {
 	command := fmt.Sprintf("git push %s %s", remoteName, tagName)
-	return c.OSCommand.DetectUnamePass(command, promptUserForCredential)
+	return c.OSCommand.RunCommandWithCredentials(command, c.getRemoteURL(remoteName), promptUserForCredential)
 }
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitCommandCreateAnnotatedTagCmdStr is a function.
+func TestGitCommandCreateAnnotatedTagCmdStr(t *testing.T) {
+	type scenario struct {
+		testName  string
+		tagName   string
+		commitSha string
+		message   string
+		sign      bool
+		expected  string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "Annotated tag on HEAD",
+			tagName:   "v1.0.0",
+			commitSha: "",
+			message:   "Release v1.0.0",
+			sign:      false,
+			expected:  `git tag -a "v1.0.0" -m "Release v1.0.0"`,
+		},
+		{
+			testName:  "Annotated tag on commit",
+			tagName:   "v1.0.0",
+			commitSha: "abc123",
+			message:   "Release v1.0.0",
+			sign:      false,
+			expected:  `git tag -a "v1.0.0" -m "Release v1.0.0" abc123`,
+		},
+		{
+			testName:  "Signed tag",
+			tagName:   "v1.0.0",
+			commitSha: "abc123",
+			message:   "Release v1.0.0",
+			sign:      true,
+			expected:  `git tag -s "v1.0.0" -m "Release v1.0.0" abc123`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			assert.Equal(t, s.expected, gitCmd.CreateAnnotatedTagCmdStr(s.tagName, s.commitSha, s.message, s.sign))
+		})
+	}
+}
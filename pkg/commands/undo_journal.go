@@ -0,0 +1,178 @@
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// The reflog only tells us about changes to HEAD, so actions like deleting a
+// branch, dropping a stash entry, or discarding changes in the working tree
+// can't be undone from it. Instead we record those actions in a journal of our
+// own, alongside whatever we need to reverse them: a deleted branch's tip, a
+// dropped stash entry's commit, or a snapshot of the changes about to be
+// discarded, made with 'git stash create'. Untracked files aren't included in
+// those snapshots, so discarding them can't be undone.
+
+const (
+	UNDO_JOURNAL_DELETE_BRANCH = "deleteBranch"
+	UNDO_JOURNAL_DROP_STASH    = "dropStash"
+	UNDO_JOURNAL_DISCARD       = "discard"
+)
+
+// we only need recent entries to undo, so the journal is capped at this length
+const maxUndoJournalEntries = 100
+
+type UndoJournalEntry struct {
+	Kind string `json:"kind"`
+	// unix timestamp of when the action happened, so that we can tell whether
+	// it's more recent than the latest action in the reflog
+	Time int64 `json:"time"`
+	// the deleted branch's tip, the dropped stash entry's commit, or the
+	// snapshot of the discarded changes
+	Sha string `json:"sha"`
+	// the deleted branch's name or the dropped stash entry's message
+	Name string `json:"name,omitempty"`
+	// the paths whose changes were discarded
+	Paths  []string `json:"paths,omitempty"`
+	Undone bool     `json:"undone,omitempty"`
+}
+
+func (c *GitCommand) undoJournalPath() string {
+	return filepath.Join(c.DotGitDir, "lazygit", "undo-journal.json")
+}
+
+func (c *GitCommand) readUndoJournal() ([]*UndoJournalEntry, error) {
+	bytes, err := ioutil.ReadFile(c.undoJournalPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*UndoJournalEntry{}
+	if err := json.Unmarshal(bytes, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (c *GitCommand) writeUndoJournal(entries []*UndoJournalEntry) error {
+	if len(entries) > maxUndoJournalEntries {
+		entries = entries[len(entries)-maxUndoJournalEntries:]
+	}
+
+	bytes, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.undoJournalPath()), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(c.undoJournalPath(), bytes, 0644)
+}
+
+// AddUndoJournalEntry records an action so that it can be undone later
+func (c *GitCommand) AddUndoJournalEntry(entry *UndoJournalEntry) error {
+	entries, err := c.readUndoJournal()
+	if err != nil {
+		return err
+	}
+
+	entry.Time = time.Now().Unix()
+
+	return c.writeUndoJournal(append(entries, entry))
+}
+
+// GetLatestUndoJournalEntry returns the most recent entry which hasn't been
+// undone yet, or nil if there's no such entry
+func (c *GitCommand) GetLatestUndoJournalEntry() (*UndoJournalEntry, error) {
+	entries, err := c.readUndoJournal()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		if !entries[i].Undone {
+			return entries[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UndoJournalEntry reverses the entry's action and marks it as undone
+func (c *GitCommand) UndoJournalEntry(entry *UndoJournalEntry) error {
+	var err error
+	switch entry.Kind {
+	case UNDO_JOURNAL_DELETE_BRANCH:
+		err = c.RunCommand("git branch %s %s", c.OSCommand.Quote(entry.Name), entry.Sha)
+	case UNDO_JOURNAL_DROP_STASH:
+		err = c.RunCommand("git stash store -m %s %s", c.OSCommand.Quote(entry.Name), entry.Sha)
+	case UNDO_JOURNAL_DISCARD:
+		err = c.restoreDiscardSnapshot(entry.Sha, entry.Paths)
+	default:
+		err = errors.New("unknown undo journal entry: " + entry.Kind)
+	}
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.readUndoJournal()
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.Time == entry.Time && e.Kind == entry.Kind && e.Sha == entry.Sha {
+			e.Undone = true
+		}
+	}
+
+	return c.writeUndoJournal(entries)
+}
+
+// GetBranchTip returns the sha of the commit the given local branch points to
+func (c *GitCommand) GetBranchTip(branchName string) (string, error) {
+	output, err := c.OSCommand.RunCommandWithOutput("git rev-parse %s", c.OSCommand.Quote("refs/heads/"+branchName))
+	return strings.TrimSpace(output), err
+}
+
+// GetStashEntrySha returns the sha of the stash entry's commit
+func (c *GitCommand) GetStashEntrySha(index int) (string, error) {
+	output, err := c.OSCommand.RunCommandWithOutput("git rev-parse stash@{%d}", index)
+	return strings.TrimSpace(output), err
+}
+
+// CreateDiscardSnapshot commits the current changes to tracked files, both
+// staged and unstaged, without touching the working tree or the stash list. It
+// returns an empty string if there are no such changes.
+func (c *GitCommand) CreateDiscardSnapshot() (string, error) {
+	output, err := c.OSCommand.RunCommandWithOutput("git stash create")
+	return strings.TrimSpace(output), err
+}
+
+// restoreDiscardSnapshot restores the given paths in both the index and the
+// working tree to how they were when the snapshot was taken. The snapshot's
+// second parent holds the index, and the snapshot itself the working tree.
+func (c *GitCommand) restoreDiscardSnapshot(sha string, paths []string) error {
+	quotedPaths := make([]string, len(paths))
+	for i, path := range paths {
+		quotedPaths[i] = c.OSCommand.Quote(path)
+	}
+	pathsArg := strings.Join(quotedPaths, " ")
+
+	if err := c.RunCommand("git restore --source=%s^2 --staged -- %s", sha, pathsArg); err != nil {
+		return err
+	}
+
+	return c.RunCommand("git restore --source=%s --worktree -- %s", sha, pathsArg)
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitCommandUndoJournal is a function.
+func TestGitCommandUndoJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lazygit-undo-journal")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	gitCmd := NewDummyGitCommand()
+	gitCmd.DotGitDir = dir
+
+	entry, err := gitCmd.GetLatestUndoJournalEntry()
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	assert.NoError(t, gitCmd.AddUndoJournalEntry(&UndoJournalEntry{Kind: UNDO_JOURNAL_DELETE_BRANCH, Sha: "aaa", Name: "feature"}))
+	assert.NoError(t, gitCmd.AddUndoJournalEntry(&UndoJournalEntry{Kind: UNDO_JOURNAL_DISCARD, Sha: "bbb", Paths: []string{"file"}}))
+
+	entry, err = gitCmd.GetLatestUndoJournalEntry()
+	assert.NoError(t, err)
+	assert.EqualValues(t, "bbb", entry.Sha)
+	assert.EqualValues(t, []string{"file"}, entry.Paths)
+
+	gitCmd.OSCommand.Command = test.CreateMockCommand(t, []*test.CommandSwapper{
+		{
+			Expect:  "git restore --source=bbb^2 --staged -- file",
+			Replace: "echo",
+		},
+		{
+			Expect:  "git restore --source=bbb --worktree -- file",
+			Replace: "echo",
+		},
+		{
+			Expect:  "git branch feature aaa",
+			Replace: "echo",
+		},
+	})
+
+	assert.NoError(t, gitCmd.UndoJournalEntry(entry))
+
+	entry, err = gitCmd.GetLatestUndoJournalEntry()
+	assert.NoError(t, err)
+	assert.EqualValues(t, "aaa", entry.Sha)
+
+	assert.NoError(t, gitCmd.UndoJournalEntry(entry))
+
+	entry, err = gitCmd.GetLatestUndoJournalEntry()
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+}
@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-errors/errors"
+)
+
+// Besides creating pull requests, we can open commits, files, lines and tags
+// in the web UI of the service that the origin remote is hosted on. They'll
+// only be found there once they've been pushed.
+
+// OpenCommit opens the commit in the browser, returning the URL
+func (pr *PullRequest) OpenCommit(sha string) (string, error) {
+	return pr.openWebURL(func(service *Service) string { return service.CommitURL }, sha)
+}
+
+// OpenFile opens the file as of the given ref in the browser, returning the URL
+func (pr *PullRequest) OpenFile(ref string, path string) (string, error) {
+	sha, err := pr.resolveRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return pr.openWebURL(func(service *Service) string { return service.FileURL }, sha, path)
+}
+
+// OpenFileLines opens the file as of the given ref in the browser with the
+// given lines highlighted, returning the URL
+func (pr *PullRequest) OpenFileLines(ref string, path string, firstLine int, lastLine int) (string, error) {
+	sha, err := pr.resolveRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return pr.openWebURL(func(service *Service) string { return service.FileLinesURL }, sha, path, firstLine, lastLine)
+}
+
+// OpenTag opens the tag (or its release, where there is one) in the browser,
+// returning the URL
+func (pr *PullRequest) OpenTag(tagName string) (string, error) {
+	return pr.openWebURL(func(service *Service) string { return service.TagURL }, tagName)
+}
+
+func (pr *PullRequest) openWebURL(getTemplate func(*Service) string, args ...interface{}) (string, error) {
+	url, err := pr.getWebURL(getTemplate, args...)
+	if err != nil {
+		return "", err
+	}
+
+	return url, pr.GitCommand.OSCommand.OpenLink(url)
+}
+
+// getWebURL fills in one of the service's URL templates with the repo's owner
+// and name, followed by the given args
+func (pr *PullRequest) getWebURL(getTemplate func(*Service) string, args ...interface{}) (string, error) {
+	repoURL := pr.GitCommand.GetRemoteURL()
+	gitService := pr.getService(repoURL)
+	if gitService == nil || getTemplate(gitService) == "" {
+		return "", errors.New(pr.GitCommand.Tr.UnsupportedGitService)
+	}
+
+	repoInfo := getRepoInfoFromURL(repoURL)
+	templateArgs := append([]interface{}{repoInfo.Owner, repoInfo.Repository}, args...)
+
+	return fillURLTemplate(getTemplate(gitService), templateArgs...), nil
+}
+
+// fillURLTemplate is like fmt.Sprintf, except that string args are escaped
+// according to where they end up in the URL: a branch name or file path can
+// contain spaces, '#', '?' or '%'. Templates refer to args by position, so we
+// can't tell where an arg goes until the template's been filled in. Instead we
+// fill it in with placeholders, and then swap each one for its escaped arg.
+func fillURLTemplate(template string, args ...interface{}) string {
+	placeholderArgs := make([]interface{}, len(args))
+	for i, arg := range args {
+		if _, ok := arg.(string); ok {
+			placeholderArgs[i] = urlPlaceholder(i)
+		} else {
+			placeholderArgs[i] = arg
+		}
+	}
+
+	result := fmt.Sprintf(template, placeholderArgs...)
+
+	for i, arg := range args {
+		str, ok := arg.(string)
+		if !ok {
+			continue
+		}
+
+		placeholder := urlPlaceholder(i)
+		for {
+			index := strings.Index(result, placeholder)
+			if index == -1 {
+				break
+			}
+
+			escaped := escapeURLPath(str)
+			if isInURLQuery(result[:index]) {
+				escaped = escapeURLQueryValue(str)
+			}
+			result = result[:index] + escaped + result[index+len(placeholder):]
+		}
+	}
+
+	return result
+}
+
+// escapeURLQueryValue leaves slashes alone, because they're allowed in a query
+// and the services expect branch names and paths with them as they are
+func escapeURLQueryValue(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "%2F", "/")
+}
+
+func urlPlaceholder(index int) string {
+	return fmt.Sprintf("\x00%d\x00", index)
+}
+
+// isInURLQuery tells us whether whatever comes after the given prefix of a URL
+// is part of its query string, rather than its path or fragment
+func isInURLQuery(prefix string) bool {
+	queryIndex := strings.Index(prefix, "?")
+	return queryIndex != -1 && !strings.Contains(prefix[queryIndex:], "#")
+}
+
+// escapeURLPath escapes each segment of the path, leaving the slashes between
+// them alone
+func escapeURLPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// resolveRef turns a ref like HEAD into a sha, because a web UI won't know what
+// our refs point to
+func (pr *PullRequest) resolveRef(ref string) (string, error) {
+	output, err := pr.GitCommand.OSCommand.RunCommandWithOutput("git rev-parse %s", pr.GitCommand.OSCommand.Quote(ref))
+	return strings.TrimSpace(output), err
+}
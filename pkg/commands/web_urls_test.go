@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/secureexec"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestOpenWebURLs is a function.
+func TestOpenWebURLs(t *testing.T) {
+	type scenario struct {
+		testName  string
+		remoteUrl string
+		open      func(*PullRequest) (string, error)
+		expected  string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "Opens a commit on github",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenCommit("abc123") },
+			expected:  "https://github.com/peter/calculator/commit/abc123",
+		},
+		{
+			testName:  "Opens a file on gitlab with nested groups",
+			remoteUrl: "git@gitlab.com:group/subgroup/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFile("HEAD", "pkg/sum.go") },
+			expected:  "https://gitlab.com/group/subgroup/calculator/-/blob/def456/pkg/sum.go",
+		},
+		{
+			testName:  "Opens lines on github",
+			remoteUrl: "https://github.com/peter/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFileLines("HEAD", "pkg/sum.go", 3, 7) },
+			expected:  "https://github.com/peter/calculator/blob/def456/pkg/sum.go#L3-L7",
+		},
+		{
+			testName:  "Opens lines on bitbucket",
+			remoteUrl: "git@bitbucket.org:peter/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFileLines("HEAD", "pkg/sum.go", 3, 7) },
+			expected:  "https://bitbucket.org/peter/calculator/src/def456/pkg/sum.go#lines-3:7",
+		},
+		{
+			testName:  "Opens lines on azure devops",
+			remoteUrl: "git@ssh.dev.azure.com:v3/myorg/myproject/calculator",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFileLines("HEAD", "pkg/sum.go", 3, 7) },
+			expected:  "https://dev.azure.com/myorg/myproject/_git/calculator?version=GCdef456&path=/pkg/sum.go&line=3&lineEnd=7&lineStartColumn=1&lineEndColumn=1",
+		},
+		{
+			testName:  "Opens lines on a custom bitbucket server",
+			remoteUrl: "ssh://git@bitbucket.work.com:7999/proj/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFileLines("HEAD", "pkg/sum.go", 3, 7) },
+			expected:  "https://code.bitbucket.work.com/projects/proj/repos/calculator/browse/pkg/sum.go?at=def456#3-7",
+		},
+		{
+			testName:  "Opens the file for lines on codecommit",
+			remoteUrl: "https://git-codecommit.eu-west-1.amazonaws.com/v1/repos/calculator",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFileLines("HEAD", "pkg/sum.go", 3, 7) },
+			expected:  "https://console.aws.amazon.com/codesuite/codecommit/repositories/calculator/browse/def456/--/pkg/sum.go?region=eu-west-1",
+		},
+		{
+			testName:  "Escapes the file path on github",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFile("HEAD", "docs/50% off #1?.md") },
+			expected:  "https://github.com/peter/calculator/blob/def456/docs/50%25%20off%20%231%3F.md",
+		},
+		{
+			testName:  "Escapes the file path in the query on azure devops",
+			remoteUrl: "git@ssh.dev.azure.com:v3/myorg/myproject/calculator",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenFile("HEAD", "docs/50% off #1?.md") },
+			expected:  "https://dev.azure.com/myorg/myproject/_git/calculator?version=GCdef456&path=/docs/50%25+off+%231%3F.md",
+		},
+		{
+			testName:  "Escapes the tag on bitbucket server",
+			remoteUrl: "ssh://git@bitbucket.work.com:7999/proj/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenTag("release#1") },
+			expected:  "https://code.bitbucket.work.com/projects/proj/repos/calculator/browse?at=refs/tags/release%231",
+		},
+		{
+			testName:  "Opens a tag on a custom gitlab",
+			remoteUrl: "git@git.work.com:peter/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenTag("v1.0.0") },
+			expected:  "https://code.work.com/peter/calculator/-/tags/v1.0.0",
+		},
+		{
+			testName:  "Opens a tag on a custom gitea",
+			remoteUrl: "git@gitea.work.com:peter/calculator.git",
+			open:      func(pr *PullRequest) (string, error) { return pr.OpenTag("v1.0.0") },
+			expected:  "https://code.gitea.work.com/peter/calculator/releases/tag/v1.0.0",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCommand := NewDummyGitCommand()
+			gitCommand.OSCommand.Command = func(cmd string, args ...string) *exec.Cmd {
+				if strings.HasPrefix(cmd, "git") {
+					assert.Equal(t, []string{"rev-parse", "HEAD"}, args)
+					return secureexec.Command("echo", "def456")
+				}
+
+				assert.Equal(t, cmd, "open")
+				assert.Equal(t, args, []string{s.expected})
+				return secureexec.Command("echo")
+			}
+			gitCommand.OSCommand.Config.GetUserConfig().OS.OpenLinkCommand = "open {{link}}"
+			gitCommand.Config.GetUserConfig().Services = map[string]string{
+				"git.work.com":       "gitlab:code.work.com",
+				"bitbucket.work.com": "bitbucketServer:code.bitbucket.work.com",
+				"gitea.work.com":     "gitea:code.gitea.work.com",
+			}
+			gitCommand.getGitConfigValue = func(path string) (string, error) {
+				assert.Equal(t, path, "remote.origin.url")
+				return s.remoteUrl, nil
+			}
+
+			url, err := s.open(NewPullRequest(gitCommand))
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, url)
+		})
+	}
+}
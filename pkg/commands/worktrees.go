@@ -0,0 +1,36 @@
+package commands
+
+import "fmt"
+
+func (c *GitCommand) WorktreeAddCmdStr(path string, branchName string, createBranch bool) string {
+	quotedPath := c.OSCommand.Quote(path)
+
+	if branchName == "" {
+		return fmt.Sprintf("git worktree add %s", quotedPath)
+	}
+
+	if createBranch {
+		return fmt.Sprintf("git worktree add -b %s %s", c.OSCommand.Quote(branchName), quotedPath)
+	}
+
+	return fmt.Sprintf("git worktree add %s %s", quotedPath, c.OSCommand.Quote(branchName))
+}
+
+// WorktreeAdd creates a new worktree at the given path. If branchName is empty
+// git will create a branch named after the final component of the path.
+func (c *GitCommand) WorktreeAdd(path string, branchName string, createBranch bool) error {
+	return c.RunCommand(c.WorktreeAddCmdStr(path, branchName, createBranch))
+}
+
+func (c *GitCommand) WorktreeRemove(path string, force bool) error {
+	forceArg := ""
+	if force {
+		forceArg = " --force"
+	}
+
+	return c.RunCommand("git worktree remove%s %s", forceArg, c.OSCommand.Quote(path))
+}
+
+func (c *GitCommand) WorktreePrune() error {
+	return c.RunCommand("git worktree prune")
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/secureexec"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitCommandWorktreeAddCmdStr is a function.
+func TestGitCommandWorktreeAddCmdStr(t *testing.T) {
+	type scenario struct {
+		testName     string
+		path         string
+		branchName   string
+		createBranch bool
+		expected     string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "No branch",
+			path:         "../feature",
+			branchName:   "",
+			createBranch: false,
+			expected:     `git worktree add "../feature"`,
+		},
+		{
+			testName:     "Existing branch",
+			path:         "../feature",
+			branchName:   "feature",
+			createBranch: false,
+			expected:     `git worktree add "../feature" "feature"`,
+		},
+		{
+			testName:     "New branch",
+			path:         "../feature",
+			branchName:   "feature",
+			createBranch: true,
+			expected:     `git worktree add -b "feature" "../feature"`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			assert.Equal(t, s.expected, gitCmd.WorktreeAddCmdStr(s.path, s.branchName, s.createBranch))
+		})
+	}
+}
+
+// TestGitCommandWorktreeRemove is a function.
+func TestGitCommandWorktreeRemove(t *testing.T) {
+	type scenario struct {
+		testName string
+		force    bool
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Remove",
+			force:    false,
+			expected: []string{"worktree", "remove", "../feature"},
+		},
+		{
+			testName: "Force remove",
+			force:    true,
+			expected: []string{"worktree", "remove", "--force", "../feature"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitCmd := NewDummyGitCommand()
+			gitCmd.OSCommand.Command = func(cmd string, args ...string) *exec.Cmd {
+				assert.EqualValues(t, "git", cmd)
+				assert.EqualValues(t, s.expected, args)
+
+				return secureexec.Command("echo")
+			}
+
+			assert.NoError(t, gitCmd.WorktreeRemove("../feature", s.force))
+		})
+	}
+}
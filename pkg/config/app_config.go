@@ -13,6 +13,7 @@ import (
 // AppConfig contains the base configuration fields required for lazygit.
 type AppConfig struct {
 	Debug          bool   `long:"debug" env:"DEBUG" default:"false"`
+	ReadOnly       bool   `long:"readonly" default:"false"`
 	Version        string `long:"version" env:"VERSION" default:"unversioned"`
 	Commit         string `long:"commit" env:"COMMIT"`
 	BuildDate      string `long:"build-date" env:"BUILD_DATE"`
@@ -29,6 +30,7 @@ type AppConfig struct {
 // from AppConfig and still be used by lazygit.
 type AppConfigurer interface {
 	GetDebug() bool
+	GetReadOnly() bool
 	GetVersion() string
 	GetCommit() string
 	GetBuildDate() string
@@ -45,7 +47,7 @@ type AppConfigurer interface {
 }
 
 // NewAppConfig makes a new app config
-func NewAppConfig(name, version, commit, date string, buildSource string, debuggingFlag bool) (*AppConfig, error) {
+func NewAppConfig(name, version, commit, date string, buildSource string, debuggingFlag bool, readOnlyFlag bool) (*AppConfig, error) {
 	configDir, err := findOrCreateConfigDir()
 	if err != nil {
 		return nil, err
@@ -71,6 +73,7 @@ func NewAppConfig(name, version, commit, date string, buildSource string, debugg
 		Commit:         commit,
 		BuildDate:      date,
 		Debug:          debuggingFlag,
+		ReadOnly:       readOnlyFlag,
 		BuildSource:    buildSource,
 		UserConfig:     userConfig,
 		UserConfigDir:  configDir,
@@ -141,6 +144,10 @@ func loadUserConfig(configDir string, base *UserConfig) (*UserConfig, error) {
 		return nil, err
 	}
 
+	if err := validateProtectedBranches(base.Git.ProtectedBranches); err != nil {
+		return nil, err
+	}
+
 	return base, nil
 }
 
@@ -159,6 +166,12 @@ func (c *AppConfig) GetDebug() bool {
 	return c.Debug
 }
 
+// GetReadOnly returns whether lazygit was started in read-only mode, either
+// with the --readonly flag or the readOnly config option
+func (c *AppConfig) GetReadOnly() bool {
+	return c.ReadOnly || c.UserConfig.ReadOnly
+}
+
 // GetVersion returns debug flag
 func (c *AppConfig) GetVersion() string {
 	return c.Version
@@ -1,5 +1,10 @@
 package config
 
+import (
+	"fmt"
+	"strings"
+)
+
 type UserConfig struct {
 	Gui                  GuiConfig        `yaml:"gui"`
 	Git                  GitConfig        `yaml:"git"`
@@ -16,6 +21,8 @@ type UserConfig struct {
 	CustomCommands       []CustomCommand   `yaml:"customCommands"`
 	Services             map[string]string `yaml:"services"`
 	NotARepository       string            `yaml:"notARepository"`
+	// ReadOnly disables every keybinding that would change the repo
+	ReadOnly bool `yaml:"readOnly"`
 }
 
 type RefresherConfig struct {
@@ -56,17 +63,26 @@ type CommitLengthConfig struct {
 }
 
 type GitConfig struct {
-	Paging              PagingConfig                  `yaml:"paging"`
-	Merging             MergingConfig                 `yaml:"merging"`
-	Pull                PullConfig                    `yaml:"pull"`
-	SkipHookPrefix      string                        `yaml:"skipHookPrefix"`
-	AutoFetch           bool                          `yaml:"autoFetch"`
-	BranchLogCmd        string                        `yaml:"branchLogCmd"`
-	AllBranchesLogCmd   string                        `yaml:"allBranchesLogCmd"`
-	OverrideGpg         bool                          `yaml:"overrideGpg"`
-	DisableForcePushing bool                          `yaml:"disableForcePushing"`
-	CommitPrefixes      map[string]CommitPrefixConfig `yaml:"commitPrefixes"`
-	ParseEmoji          bool                          `yaml:"parseEmoji"`
+	Paging            PagingConfig                  `yaml:"paging"`
+	Merging           MergingConfig                 `yaml:"merging"`
+	Pull              PullConfig                    `yaml:"pull"`
+	Log               LogConfig                     `yaml:"log"`
+	SkipHookPrefix    string                        `yaml:"skipHookPrefix"`
+	AutoFetch         bool                          `yaml:"autoFetch"`
+	BranchLogCmd      string                        `yaml:"branchLogCmd"`
+	AllBranchesLogCmd string                        `yaml:"allBranchesLogCmd"`
+	OverrideGpg       bool                          `yaml:"overrideGpg"`
+	CommitPrefixes    map[string]CommitPrefixConfig `yaml:"commitPrefixes"`
+	ParseEmoji        bool                          `yaml:"parseEmoji"`
+	UpdateRefs        bool                          `yaml:"updateRefs"`
+	SafetySnapshots   bool                          `yaml:"safetySnapshots"`
+	ProtectedBranches []ProtectedBranchConfig       `yaml:"protectedBranches"`
+	PullRequestStatus PullRequestStatusConfig       `yaml:"pullRequestStatus"`
+	// remember credentials in memory until lazygit quits
+	CacheCredentials bool `yaml:"cacheCredentials"`
+	// deprecated: use protectedBranches instead. When on, it blocks force
+	// pushing to every branch
+	DisableForcePushing bool `yaml:"disableForcePushing"`
 }
 
 type PagingConfig struct {
@@ -84,11 +100,80 @@ type PullConfig struct {
 	Mode string `yaml:"mode"`
 }
 
+type LogConfig struct {
+	// one of 'always' | 'never' | 'when-maximised'
+	ShowGraph string `yaml:"showGraph"`
+	// verifying each commit's signature can be slow, so this is opt-in
+	ShowSignature bool `yaml:"showSignature"`
+}
+
+type PullRequestStatusConfig struct {
+	// when on, we ask the hosting service's API for each branch's open pull
+	// request every fetchInterval seconds
+	Enabled bool `yaml:"enabled"`
+	// shell commands that print an API token, keyed by the domain of the repo's
+	// remote URL (as in the services config)
+	TokenCommands map[string]string `yaml:"tokenCommands"`
+}
+
 type CommitPrefixConfig struct {
 	Pattern string `yaml:"pattern"`
 	Replace string `yaml:"replace"`
 }
 
+// ProtectedBranchConfig sets how actions that rewrite or remove branches are
+// treated for branches matching the glob pattern. Each action is one of
+// 'allow' (the default), 'confirm', or 'block', in any case.
+type ProtectedBranchConfig struct {
+	Pattern   string `yaml:"pattern"`
+	ForcePush string `yaml:"forcePush"`
+	HardReset string `yaml:"hardReset"`
+	Rebase    string `yaml:"rebase"`
+	Delete    string `yaml:"delete"`
+}
+
+const (
+	PROTECTION_ALLOW   = "allow"
+	PROTECTION_CONFIRM = "confirm"
+	PROTECTION_BLOCK   = "block"
+)
+
+// NormalizeProtection returns the protection setting in lowercase, or "" if it
+// isn't one we know. An empty setting means 'allow'.
+func NormalizeProtection(protection string) string {
+	switch normalized := strings.ToLower(strings.TrimSpace(protection)); normalized {
+	case "":
+		return PROTECTION_ALLOW
+	case PROTECTION_ALLOW, PROTECTION_CONFIRM, PROTECTION_BLOCK:
+		return normalized
+	}
+
+	return ""
+}
+
+// validateProtectedBranches returns an error for the first protection setting
+// we don't know, so that a typo doesn't quietly leave a branch unprotected
+func validateProtectedBranches(protectedBranches []ProtectedBranchConfig) error {
+	for i, protectedBranch := range protectedBranches {
+		settings := []struct{ key, value string }{
+			{"forcePush", protectedBranch.ForcePush},
+			{"hardReset", protectedBranch.HardReset},
+			{"rebase", protectedBranch.Rebase},
+			{"delete", protectedBranch.Delete},
+		}
+		for _, setting := range settings {
+			if NormalizeProtection(setting.value) == "" {
+				return fmt.Errorf(
+					"invalid value '%s' for git.protectedBranches[%d].%s: must be one of '%s', '%s', or '%s'",
+					setting.value, i, setting.key, PROTECTION_ALLOW, PROTECTION_CONFIRM, PROTECTION_BLOCK,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
 type UpdateConfig struct {
 	Method string `yaml:"method"`
 	Days   int64  `yaml:"days"`
@@ -117,6 +202,7 @@ type KeybindingUniversalConfig struct {
 	NextItem                     string `yaml:"nextItem"`
 	PrevItemAlt                  string `yaml:"prevItem-alt"`
 	NextItemAlt                  string `yaml:"nextItem-alt"`
+	ToggleRangeSelect            string `yaml:"toggleRangeSelect"`
 	PrevPage                     string `yaml:"prevPage"`
 	NextPage                     string `yaml:"nextPage"`
 	GotoTop                      string `yaml:"gotoTop"`
@@ -162,6 +248,7 @@ type KeybindingUniversalConfig struct {
 	DiffingMenu                  string `yaml:"diffingMenu"`
 	DiffingMenuAlt               string `yaml:"diffingMenu-alt"`
 	CopyToClipboard              string `yaml:"copyToClipboard"`
+	OpenInBrowser                string `yaml:"openInBrowser"`
 	OpenRecentRepos              string `yaml:"openRecentRepos"`
 	SubmitEditorText             string `yaml:"submitEditorText"`
 	AppendNewline                string `yaml:"appendNewline"`
@@ -173,6 +260,7 @@ type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	ForgetCredentials   string `yaml:"forgetCredentials"`
 }
 
 type KeybindingFilesConfig struct {
@@ -189,6 +277,9 @@ type KeybindingFilesConfig struct {
 	Fetch                    string `yaml:"fetch"`
 	ToggleTreeView           string `yaml:"toggleTreeView"`
 	OpenMergeTool            string `yaml:"openMergeTool"`
+	Blame                    string `yaml:"blame"`
+	Absorb                   string `yaml:"absorb"`
+	ViewSafetySnapshots      string `yaml:"viewSafetySnapshots"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -204,6 +295,7 @@ type KeybindingBranchesConfig struct {
 	PushTag                string `yaml:"pushTag"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
+	RebaseOnto             string `yaml:"rebaseOnto"`
 }
 
 type KeybindingCommitsConfig struct {
@@ -226,6 +318,11 @@ type KeybindingCommitsConfig struct {
 	CheckoutCommit               string `yaml:"checkoutCommit"`
 	ResetCherryPick              string `yaml:"resetCherryPick"`
 	CopyCommitMessageToClipboard string `yaml:"copyCommitMessageToClipboard"`
+	ViewBisectOptions            string `yaml:"viewBisectOptions"`
+	SplitCommit                  string `yaml:"splitCommit"`
+	InsertExecTodo               string `yaml:"insertExecTodo"`
+	InsertBreakTodo              string `yaml:"insertBreakTodo"`
+	RebaseOnto                   string `yaml:"rebaseOnto"`
 }
 
 type KeybindingStashConfig struct {
@@ -234,6 +331,7 @@ type KeybindingStashConfig struct {
 
 type KeybindingCommitFilesConfig struct {
 	CheckoutCommitFile string `yaml:"checkoutCommitFile"`
+	Blame              string `yaml:"blame"`
 }
 
 type KeybindingMainConfig struct {
@@ -327,13 +425,25 @@ func GetDefaultConfig() *UserConfig {
 			Pull: PullConfig{
 				Mode: "auto",
 			},
-			SkipHookPrefix:      "WIP",
-			AutoFetch:           true,
-			BranchLogCmd:        "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
-			AllBranchesLogCmd:   "git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium",
+			Log: LogConfig{
+				ShowGraph:     "always",
+				ShowSignature: false,
+			},
+			SkipHookPrefix:    "WIP",
+			AutoFetch:         true,
+			BranchLogCmd:      "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
+			AllBranchesLogCmd: "git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium",
+			CommitPrefixes:    map[string]CommitPrefixConfig(nil),
+			ParseEmoji:        false,
+			UpdateRefs:        false,
+			SafetySnapshots:   false,
+			ProtectedBranches: []ProtectedBranchConfig(nil),
+			PullRequestStatus: PullRequestStatusConfig{
+				Enabled:       false,
+				TokenCommands: map[string]string(nil),
+			},
+			CacheCredentials:    false,
 			DisableForcePushing: false,
-			CommitPrefixes:      map[string]CommitPrefixConfig(nil),
-			ParseEmoji:          false,
 		},
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
@@ -358,6 +468,7 @@ func GetDefaultConfig() *UserConfig {
 				NextItem:                     "<down>",
 				PrevItemAlt:                  "k",
 				NextItemAlt:                  "j",
+				ToggleRangeSelect:            "V",
 				PrevPage:                     ",",
 				NextPage:                     ".",
 				GotoTop:                      "<",
@@ -404,6 +515,7 @@ func GetDefaultConfig() *UserConfig {
 				DiffingMenu:                  "W",
 				DiffingMenuAlt:               "<c-e>",
 				CopyToClipboard:              "<c-o>",
+				OpenInBrowser:                "G",
 				SubmitEditorText:             "<enter>",
 				AppendNewline:                "<a-enter>",
 				ExtrasMenu:                   "@",
@@ -413,6 +525,7 @@ func GetDefaultConfig() *UserConfig {
 				CheckForUpdate:      "u",
 				RecentRepos:         "<enter>",
 				AllBranchesLogGraph: "a",
+				ForgetCredentials:   "F",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
@@ -428,6 +541,9 @@ func GetDefaultConfig() *UserConfig {
 				Fetch:                    "f",
 				ToggleTreeView:           "`",
 				OpenMergeTool:            "M",
+				Blame:                    "B",
+				Absorb:                   "b",
+				ViewSafetySnapshots:      "Z",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -442,6 +558,7 @@ func GetDefaultConfig() *UserConfig {
 				PushTag:                "P",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
+				RebaseOnto:             "O",
 			},
 			Commits: KeybindingCommitsConfig{
 				SquashDown:                   "s",
@@ -463,12 +580,18 @@ func GetDefaultConfig() *UserConfig {
 				CheckoutCommit:               "<space>",
 				ResetCherryPick:              "<c-R>",
 				CopyCommitMessageToClipboard: "<c-y>",
+				ViewBisectOptions:            "b",
+				SplitCommit:                  "X",
+				InsertExecTodo:               "E",
+				InsertBreakTodo:              "B",
+				RebaseOnto:                   "O",
 			},
 			Stash: KeybindingStashConfig{
 				PopStash: "g",
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
 				CheckoutCommitFile: "c",
+				Blame:              "B",
 			},
 			Main: KeybindingMainConfig{
 				ToggleDragSelect:    "v",
@@ -487,5 +610,6 @@ func GetDefaultConfig() *UserConfig {
 		CustomCommands:       []CustomCommand(nil),
 		Services:             map[string]string(nil),
 		NotARepository:       "prompt",
+		ReadOnly:             false,
 	}
 }
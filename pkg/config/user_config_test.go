@@ -0,0 +1,51 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidateProtectedBranches is a function.
+func TestValidateProtectedBranches(t *testing.T) {
+	type scenario struct {
+		testName          string
+		protectedBranches []ProtectedBranchConfig
+		expectedErr       string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:          "no protected branches",
+			protectedBranches: nil,
+			expectedErr:       "",
+		},
+		{
+			testName: "valid settings in any case",
+			protectedBranches: []ProtectedBranchConfig{
+				{Pattern: "main", ForcePush: "Block", HardReset: "confirm", Rebase: " ALLOW "},
+			},
+			expectedErr: "",
+		},
+		{
+			testName: "unknown setting",
+			protectedBranches: []ProtectedBranchConfig{
+				{Pattern: "main", ForcePush: "block"},
+				{Pattern: "release/**", Delete: "warning"},
+			},
+			expectedErr: "invalid value 'warning' for git.protectedBranches[1].delete: must be one of 'allow', 'confirm', or 'block'",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			err := validateProtectedBranches(s.protectedBranches)
+			if s.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, s.expectedErr)
+			}
+		})
+	}
+}
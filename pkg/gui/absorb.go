@@ -0,0 +1,54 @@
+package gui
+
+// Absorbing takes each staged hunk and works out which commit on the branch last
+// touched its lines. We then create a fixup commit against each such commit and
+// squash them all in with an autosquash rebase.
+
+func (gui *Gui) handleAbsorb() error {
+	if ok, err := gui.validateNotInFilterMode(); err != nil || !ok {
+		return err
+	}
+
+	if ok, err := gui.validateNormalWorkingTreeState(); !ok {
+		return err
+	}
+
+	if len(gui.stagedFiles()) == 0 {
+		return gui.createErrorPanel(gui.Tr.NoFilesStagedTitle)
+	}
+
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.AbsorbTitle,
+			prompt: gui.Tr.AbsorbPrompt,
+			handleConfirm: func() error {
+				// see the comment on withGpgHandling for why there's no waiting
+				// status when gpg is involved
+				if gui.GitCommand.UsingGpg() {
+					return gui.absorb()
+				}
+
+				return gui.WithWaitingStatus(gui.Tr.RebasingStatus, gui.absorb)
+			},
+		})
+	})
+}
+
+func (gui *Gui) absorb() error {
+	gitCommand := gui.GitCommand.WithSpan(gui.Tr.Spans.Absorb)
+
+	sha, err := gitCommand.CreateAbsorbFixupCommits(gui.State.Commits, func(cmdStr string) error {
+		return gui.runCommitCommand(gitCommand, cmdStr)
+	})
+	if err != nil {
+		_ = gui.refreshSidePanels(refreshOptions{mode: ASYNC})
+		return gui.surfaceError(err)
+	}
+
+	if sha == "" {
+		return gui.createErrorPanel(gui.Tr.NothingToAbsorb)
+	}
+
+	err = gitCommand.SquashAllAboveFixupCommits(sha)
+	return gui.handleGenericMergeCommandResult(err)
+}
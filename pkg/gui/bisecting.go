@@ -0,0 +1,173 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// refForLog returns the ref whose history we show in the commits panel. While
+// bisecting, git checks out each candidate commit in turn, so if we just used
+// HEAD we'd lose sight of the newer commits in the range being bisected.
+func (gui *Gui) refForLog() string {
+	info := gui.GitCommand.GetBisectInfo()
+	gui.State.Modes.Bisecting.SetInfo(info)
+
+	if !info.Bisecting() {
+		return "HEAD"
+	}
+
+	return info.Start
+}
+
+func (gui *Gui) handleOpenBisectMenu() error {
+	if ok, err := gui.validateNotInFilterMode(); err != nil || !ok {
+		return err
+	}
+
+	commit := gui.getSelectedLocalCommit()
+	if commit == nil {
+		return nil
+	}
+
+	return gui.createBisectMenu(commit.Sha)
+}
+
+func (gui *Gui) createBisectMenu(sha string) error {
+	info := gui.State.Modes.Bisecting.GetInfo()
+	shortSha := utils.SafeTruncate(sha, 8)
+
+	markString := func(term string) string {
+		return utils.ResolvePlaceholderString(
+			gui.Tr.LcBisectMark,
+			map[string]string{"ref": shortSha, "term": term},
+		)
+	}
+
+	menuItems := []*menuItem{
+		{
+			displayString: markString(info.NewTerm),
+			onPress: func() error {
+				return gui.bisectMark(sha, info.NewTerm)
+			},
+		},
+		{
+			displayString: markString(info.OldTerm),
+			onPress: func() error {
+				return gui.bisectMark(sha, info.OldTerm)
+			},
+		},
+	}
+
+	if info.Bisecting() {
+		menuItems = append(menuItems,
+			&menuItem{
+				displayString: markString("skip"),
+				onPress: func() error {
+					return gui.bisectMark(sha, "skip")
+				},
+			},
+			&menuItem{
+				displayStrings: []string{gui.Tr.LcResetBisect, utils.ColoredString("git bisect reset", color.FgRed)},
+				onPress:        gui.resetBisect,
+			},
+		)
+	}
+
+	return gui.createMenu(gui.Tr.BisectMenuTitle, menuItems, createMenuOptions{showCancel: true})
+}
+
+// bisectMark marks the given commit, starting a new bisect first if we're not
+// already bisecting
+func (gui *Gui) bisectMark(sha string, term string) error {
+	return gui.WithWaitingStatus(gui.Tr.LcBisectingStatus, func() error {
+		if !gui.State.Modes.Bisecting.Active() {
+			if err := gui.GitCommand.WithSpan(gui.Tr.Spans.StartBisect).BisectStart(); err != nil {
+				return gui.surfaceError(err)
+			}
+		}
+
+		if err := gui.GitCommand.WithSpan(gui.Tr.Spans.BisectMark).BisectMark(sha, term); err != nil {
+			return gui.surfaceError(err)
+		}
+
+		return gui.afterBisectMarkRefresh()
+	})
+}
+
+func (gui *Gui) afterBisectMarkRefresh() error {
+	if err := gui.refreshSidePanels(refreshOptions{mode: SYNC}); err != nil {
+		return err
+	}
+
+	info := gui.State.Modes.Bisecting.GetInfo()
+	done, candidates, err := gui.GitCommand.IsBisectDone(info)
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	if !done {
+		return nil
+	}
+
+	return gui.showBisectCompletePopup(candidates)
+}
+
+func (gui *Gui) showBisectCompletePopup(candidates []string) error {
+	var prompt string
+	if len(candidates) == 1 {
+		prompt = utils.ResolvePlaceholderString(
+			gui.Tr.BisectComplete,
+			map[string]string{"commit": gui.bisectCandidateDescription(candidates[0])},
+		)
+	} else {
+		descriptions := make([]string, len(candidates))
+		for i, sha := range candidates {
+			descriptions[i] = gui.bisectCandidateDescription(sha)
+		}
+
+		prompt = utils.ResolvePlaceholderString(
+			gui.Tr.BisectCompleteWithSkips,
+			map[string]string{"commits": strings.Join(descriptions, "\n")},
+		)
+	}
+
+	return gui.ask(askOpts{
+		title:         gui.Tr.BisectCompleteTitle,
+		prompt:        prompt,
+		handleConfirm: gui.doResetBisect,
+	})
+}
+
+func (gui *Gui) bisectCandidateDescription(sha string) string {
+	for _, commit := range gui.State.Commits {
+		if commit.Sha == sha {
+			return fmt.Sprintf("%s %s", utils.SafeTruncate(sha, 8), commit.Name)
+		}
+	}
+
+	return utils.SafeTruncate(sha, 8)
+}
+
+func (gui *Gui) resetBisect() error {
+	return gui.ask(askOpts{
+		title:         gui.Tr.ResetBisectTitle,
+		prompt:        gui.Tr.ResetBisectPrompt,
+		handleConfirm: gui.doResetBisect,
+	})
+}
+
+func (gui *Gui) doResetBisect() error {
+	return gui.WithWaitingStatus(gui.Tr.LcBisectingStatus, func() error {
+		if err := gui.GitCommand.WithSpan(gui.Tr.Spans.ResetBisect).BisectReset(); err != nil {
+			return gui.surfaceError(err)
+		}
+
+		gui.State.Modes.Bisecting.SetInfo(models.NewBisectInfo())
+
+		return gui.refreshSidePanels(refreshOptions{mode: SYNC})
+	})
+}
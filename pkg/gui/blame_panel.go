@@ -0,0 +1,223 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The blame 'pseudo-panel' uses the main view to show which commit last
+// changed each line of a file. From there the user can jump to a line's commit
+// in the commits panel, or step back to blame the file as it was just before
+// that commit (and then forward again).
+
+func (gui *Gui) handleBlameFile() error {
+	file := gui.getSelectedFile()
+	if file == nil {
+		return nil
+	}
+
+	// leaving the ref blank so that we blame the file as it is in the working tree
+	return gui.openBlame(file.Name, "", 0)
+}
+
+func (gui *Gui) handleBlameCommitFile() error {
+	file := gui.getSelectedCommitFile()
+	if file == nil {
+		return nil
+	}
+
+	return gui.openBlame(file.Name, gui.State.Panels.CommitFiles.refName, 0)
+}
+
+func (gui *Gui) openBlame(filename string, ref string, selectedLineIdx int) error {
+	state, err := gui.loadBlame(filename, ref, selectedLineIdx)
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	gui.State.Panels.Blame = state
+
+	return gui.pushContext(gui.State.Contexts.Blame)
+}
+
+func (gui *Gui) loadBlame(filename string, ref string, selectedLineIdx int) (*BlamePanelState, error) {
+	lines, err := gui.GitCommand.GetBlame(filename, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if selectedLineIdx > len(lines)-1 {
+		selectedLineIdx = len(lines) - 1
+	}
+
+	return &BlamePanelState{
+		Lines:           lines,
+		SelectedLineIdx: selectedLineIdx,
+		Filename:        filename,
+		Ref:             ref,
+	}, nil
+}
+
+func (gui *Gui) getSelectedBlameLine() *models.BlameLine {
+	state := gui.State.Panels.Blame
+	if state == nil || state.SelectedLineIdx < 0 || state.SelectedLineIdx > len(state.Lines)-1 {
+		return nil
+	}
+
+	return state.Lines[state.SelectedLineIdx]
+}
+
+func (gui *Gui) renderBlame() error {
+	state := gui.State.Panels.Blame
+	if state == nil {
+		return nil
+	}
+
+	title := state.Filename
+	if state.Ref != "" {
+		title = fmt.Sprintf("%s @ %s", state.Filename, utils.SafeTruncate(state.Ref, 8))
+	}
+
+	if err := gui.refreshMainViews(refreshMainOpts{
+		main: &viewUpdateOpts{
+			title:  fmt.Sprintf("%s: %s", gui.Tr.BlameTitle, title),
+			task:   NewRenderStringWithoutScrollTask(presentation.GetBlameDisplayString(state.Lines, state.SelectedLineIdx)),
+			noWrap: true,
+		},
+	}); err != nil {
+		return err
+	}
+
+	return gui.focusBlameSelection(state)
+}
+
+// focusBlameSelection scrolls the main view just enough to keep the selected
+// line in view
+func (gui *Gui) focusBlameSelection(state *BlamePanelState) error {
+	mainView := gui.Views.Main
+
+	_, viewHeight := mainView.Size()
+	_, origin := mainView.Origin()
+
+	newOrigin := origin
+	if state.SelectedLineIdx < origin {
+		newOrigin = state.SelectedLineIdx
+	} else if state.SelectedLineIdx > origin+viewHeight-1 {
+		newOrigin = state.SelectedLineIdx - viewHeight + 1
+	}
+
+	gui.g.Update(func(*gocui.Gui) error {
+		return mainView.SetOrigin(0, newOrigin)
+	})
+
+	return nil
+}
+
+func (gui *Gui) handleBlameSelectPrevLine() error {
+	return gui.moveBlameSelection(-1)
+}
+
+func (gui *Gui) handleBlameSelectNextLine() error {
+	return gui.moveBlameSelection(1)
+}
+
+func (gui *Gui) moveBlameSelection(delta int) error {
+	state := gui.State.Panels.Blame
+	if state == nil {
+		return nil
+	}
+
+	newIdx := state.SelectedLineIdx + delta
+	if newIdx < 0 || newIdx > len(state.Lines)-1 {
+		return nil
+	}
+	state.SelectedLineIdx = newIdx
+
+	return gui.renderBlame()
+}
+
+// handleBlameGoToCommit selects the blamed line's commit in the commits panel
+func (gui *Gui) handleBlameGoToCommit() error {
+	line := gui.getSelectedBlameLine()
+	if line == nil {
+		return nil
+	}
+
+	if line.Commit.IsUncommitted() {
+		return gui.createErrorPanel(gui.Tr.BlameLineNotCommitted)
+	}
+
+	index := gui.commitIndex(line.Commit.Sha)
+	if index == -1 && gui.State.Panels.Commits.LimitCommits {
+		// the commit may simply be older than the commits we've loaded so far
+		gui.State.Panels.Commits.LimitCommits = false
+		if err := gui.refreshCommitsWithLimit(); err != nil {
+			return gui.surfaceError(err)
+		}
+		index = gui.commitIndex(line.Commit.Sha)
+	}
+
+	if index == -1 {
+		return gui.createErrorPanel(gui.Tr.BlameCommitNotFound)
+	}
+
+	gui.State.Panels.Commits.SelectedLineIdx = index
+	gui.State.Panels.Blame = nil
+
+	return gui.pushContext(gui.State.Contexts.BranchCommits)
+}
+
+func (gui *Gui) commitIndex(sha string) int {
+	for i, commit := range gui.State.Commits {
+		if commit.Sha == sha {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// handleBlameParent blames the file as it was just before the selected line's
+// commit, so that we can see what the line looked like beforehand
+func (gui *Gui) handleBlameParent() error {
+	line := gui.getSelectedBlameLine()
+	if line == nil {
+		return nil
+	}
+
+	if line.Commit.PreviousSha == "" {
+		return gui.createErrorPanel(gui.Tr.NoEarlierBlame)
+	}
+
+	newState, err := gui.loadBlame(line.Commit.PreviousFilename, line.Commit.PreviousSha, line.OriginalLineNumber-1)
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	newState.Previous = gui.State.Panels.Blame
+	gui.State.Panels.Blame = newState
+
+	return gui.renderBlame()
+}
+
+// handleBlameStepForward undoes a previous step back to a parent commit
+func (gui *Gui) handleBlameStepForward() error {
+	state := gui.State.Panels.Blame
+	if state == nil || state.Previous == nil {
+		return nil
+	}
+
+	gui.State.Panels.Blame = state.Previous
+
+	return gui.renderBlame()
+}
+
+func (gui *Gui) handleEscapeBlame() error {
+	gui.State.Panels.Blame = nil
+
+	return gui.returnFromContext()
+}
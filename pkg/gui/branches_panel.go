@@ -68,7 +68,16 @@ func (gui *Gui) refreshBranches() {
 	if err != nil {
 		_ = gui.surfaceError(err)
 	}
-	gui.State.Branches = builder.Build()
+	branches := builder.Build()
+	branchNames := make([]string, len(branches))
+	gui.Mutexes.PullRequestsMutex.Lock()
+	for i, branch := range branches {
+		branchNames[i] = branch.Name
+		branch.PullRequest = gui.State.PullRequests.byBranch[branch.Name]
+	}
+	gui.State.PullRequests.branchNames = branchNames
+	gui.Mutexes.PullRequestsMutex.Unlock()
+	gui.State.Branches = branches
 
 	if err := gui.postRefreshUpdate(gui.State.Contexts.Branches); err != nil {
 		gui.Log.Error(err)
@@ -272,7 +281,9 @@ func (gui *Gui) deleteBranch(force bool) error {
 	if checkedOutBranch.Name == selectedBranch.Name {
 		return gui.createErrorPanel(gui.Tr.CantDeleteCheckOutBranch)
 	}
-	return gui.deleteNamedBranch(selectedBranch, force)
+	return gui.withBranchProtection(selectedBranch.Name, DELETE_BRANCH, func() error {
+		return gui.deleteNamedBranch(selectedBranch, force)
+	})
 }
 
 func (gui *Gui) deleteNamedBranch(selectedBranch *models.Branch, force bool) error {
@@ -294,6 +305,10 @@ func (gui *Gui) deleteNamedBranch(selectedBranch *models.Branch, force bool) err
 		title:  title,
 		prompt: message,
 		handleConfirm: func() error {
+			journalEntry, err := gui.newBranchDeleteJournalEntry(selectedBranch.Name)
+			if err != nil {
+				return gui.surfaceError(err)
+			}
 			if err := gui.GitCommand.WithSpan(gui.Tr.Spans.DeleteBranch).DeleteBranch(selectedBranch.Name, force); err != nil {
 				errMessage := err.Error()
 				if !force && strings.Contains(errMessage, "is not fully merged") {
@@ -301,6 +316,9 @@ func (gui *Gui) deleteNamedBranch(selectedBranch *models.Branch, force bool) err
 				}
 				return gui.createErrorPanel(errMessage)
 			}
+			if err := gui.journal(journalEntry); err != nil {
+				return gui.surfaceError(err)
+			}
 			return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{BRANCHES}})
 		},
 	})
@@ -367,13 +385,15 @@ func (gui *Gui) handleRebaseOntoBranch(selectedBranchName string) error {
 		},
 	)
 
-	return gui.ask(askOpts{
-		title:  gui.Tr.RebasingTitle,
-		prompt: prompt,
-		handleConfirm: func() error {
-			err := gui.GitCommand.WithSpan(gui.Tr.Spans.RebaseBranch).RebaseBranch(selectedBranchName)
-			return gui.handleGenericMergeCommandResult(err)
-		},
+	return gui.withBranchProtection(checkedOutBranch, REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.RebasingTitle,
+			prompt: prompt,
+			handleConfirm: func() error {
+				err := gui.GitCommand.WithSpan(gui.Tr.Spans.RebaseBranch).RebaseBranch(selectedBranchName)
+				return gui.handleGenericMergeCommandResult(err)
+			},
+		})
 	})
 }
 
@@ -414,7 +434,9 @@ func (gui *Gui) handleFastForward() error {
 		if gui.State.Panels.Branches.SelectedLineIdx == 0 {
 			_ = gui.pullWithMode("ff-only", PullFilesOptions{span: span})
 		} else {
-			err := gui.GitCommand.WithSpan(span).FastForward(branch.Name, remoteName, remoteBranchName, gui.promptUserForCredential)
+			credentials := gui.newCredentialSession(true)
+			err := gui.GitCommand.WithSpan(span).FastForward(branch.Name, remoteName, remoteBranchName, credentials.promptUserForCredential)
+			credentials.commandFinished(err)
 			gui.handleCredentialsPopup(err)
 			_ = gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{BRANCHES}})
 		}
@@ -33,6 +33,10 @@ func (gui *Gui) handleCopyCommit() error {
 		return err
 	}
 
+	if context.GetPanelState().IsRangeSelect() {
+		return gui.copySelectedCommits(context)
+	}
+
 	item, ok := context.SelectedItem()
 	if !ok {
 		return nil
@@ -42,6 +46,10 @@ func (gui *Gui) handleCopyCommit() error {
 		return nil
 	}
 
+	if commit.Sha == "" {
+		return gui.createErrorPanel(gui.Tr.TodoCommandHasNoCommit)
+	}
+
 	// we will un-copy it if it's already copied
 	for index, cherryPickedCommit := range gui.State.Modes.CherryPicking.CherryPickedCommits {
 		if commit.Sha == cherryPickedCommit.Sha {
@@ -54,6 +62,49 @@ func (gui *Gui) handleCopyCommit() error {
 	return context.HandleRender()
 }
 
+// copySelectedCommits copies every commit in the selected range, or un-copies
+// them if they've all been copied already
+func (gui *Gui) copySelectedCommits(context *ListContext) error {
+	commitsList := gui.commitsListForContext()
+	start, end := context.GetPanelState().GetSelectedLineRange()
+	if end > len(commitsList)-1 {
+		end = len(commitsList) - 1
+	}
+
+	commitShaMap := gui.cherryPickedCommitShaMap()
+	allCopied := true
+	for _, commit := range commitsList[start : end+1] {
+		// rebase todo commands like exec have no commit to copy
+		if commit.Sha != "" && !commitShaMap[commit.Sha] {
+			allCopied = false
+			break
+		}
+	}
+
+	if allCopied {
+		selectedShaMap := map[string]bool{}
+		for _, commit := range commitsList[start : end+1] {
+			selectedShaMap[commit.Sha] = true
+		}
+
+		newCommits := []*models.Commit{}
+		for _, commit := range gui.State.Modes.CherryPicking.CherryPickedCommits {
+			if !selectedShaMap[commit.Sha] {
+				newCommits = append(newCommits, commit)
+			}
+		}
+		gui.State.Modes.CherryPicking.CherryPickedCommits = newCommits
+	} else {
+		for index := start; index <= end; index++ {
+			if commitsList[index].Sha != "" {
+				gui.addCommitToCherryPickedCommits(index)
+			}
+		}
+	}
+
+	return context.HandleRender()
+}
+
 func (gui *Gui) cherryPickedCommitShaMap() map[string]bool {
 	commitShaMap := map[string]bool{}
 	for _, commit := range gui.State.Modes.CherryPicking.CherryPickedCommits {
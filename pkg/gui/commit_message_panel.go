@@ -25,7 +25,7 @@ func (gui *Gui) handleCommitConfirm() error {
 	return gui.withGpgHandling(cmdStr, gui.Tr.CommittingStatus, func() error {
 		_ = gui.returnFromContext()
 		gui.clearEditorView(gui.Views.CommitMessage)
-		return nil
+		return gui.afterSplitCommit()
 	})
 }
 
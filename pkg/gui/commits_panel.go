@@ -2,6 +2,7 @@ package gui
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/jesseduffield/lazygit/pkg/commands"
@@ -21,6 +22,36 @@ func (gui *Gui) getSelectedLocalCommit() *models.Commit {
 	return gui.State.Commits[selectedLine]
 }
 
+func (gui *Gui) shouldShowGraph() bool {
+	// when filtering by path, git doesn't rewrite each commit's parents to
+	// commits in the filtered list, so there's no graph to draw
+	if gui.State.Modes.Filtering.Active() {
+		return false
+	}
+
+	switch gui.Config.GetUserConfig().Git.Log.ShowGraph {
+	case "always":
+		return true
+	case "when-maximised":
+		return gui.State.ScreenMode != SCREEN_NORMAL
+	default:
+		return false
+	}
+}
+
+// shouldLoadCommitsForGraph tells us whether to load commits in the order the
+// graph needs. The graph can appear when the screen mode changes, without the
+// commits being reloaded, so we go by whether it can be shown rather than by
+// whether it's shown right now.
+func (gui *Gui) shouldLoadCommitsForGraph() bool {
+	if gui.State.Modes.Filtering.Active() {
+		return false
+	}
+
+	showGraph := gui.Config.GetUserConfig().Git.Log.ShowGraph
+	return showGraph == "always" || showGraph == "when-maximised"
+}
+
 func (gui *Gui) handleCommitSelect() error {
 	state := gui.State.Panels.Commits
 	if state.SelectedLineIdx > 290 && state.LimitCommits {
@@ -38,6 +69,9 @@ func (gui *Gui) handleCommitSelect() error {
 	commit := gui.getSelectedLocalCommit()
 	if commit == nil {
 		task = NewRenderStringTask(gui.Tr.NoCommitsThisBranch)
+	} else if commit.Sha == "" {
+		// todo commands like exec have no commit to show, so we show the todo itself
+		task = NewRenderStringTask(strings.TrimSpace(commit.Action + " " + commit.Name))
 	} else {
 		cmd := gui.OSCommand.ExecutableFromString(
 			gui.GitCommand.ShowCmdStr(commit.Sha, gui.State.Modes.Filtering.GetPath()),
@@ -76,6 +110,9 @@ func (gui *Gui) refreshReflogCommitsConsideringStartup() {
 // counts can change. Whenever we change branches we should probably also change commits
 // e.g. in the case of switching branches.
 func (gui *Gui) refreshCommits() error {
+	gui.refreshSplittingMode()
+	gui.refreshRebaseTodoHistory()
+
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 
@@ -121,7 +158,8 @@ func (gui *Gui) refreshCommitsWithLimit() error {
 			Limit:                gui.State.Panels.Commits.LimitCommits,
 			FilterPath:           gui.State.Modes.Filtering.GetPath(),
 			IncludeRebaseCommits: true,
-			RefName:              "HEAD",
+			RefName:              gui.refForLog(),
+			TopoOrder:            gui.shouldLoadCommitsForGraph(),
 		},
 	)
 	if err != nil {
@@ -166,15 +204,22 @@ func (gui *Gui) handleCommitSquashDown() error {
 		return nil
 	}
 
-	return gui.ask(askOpts{
-		title:  gui.Tr.Squash,
-		prompt: gui.Tr.SureSquashThisCommit,
-		handleConfirm: func() error {
-			return gui.WithWaitingStatus(gui.Tr.SquashingStatus, func() error {
-				err := gui.GitCommand.WithSpan(gui.Tr.Spans.SquashCommitDown).InteractiveRebase(gui.State.Commits, gui.State.Panels.Commits.SelectedLineIdx, "squash")
-				return gui.handleGenericMergeCommandResult(err)
-			})
-		},
+	prompt := gui.Tr.SureSquashThisCommit
+	if gui.State.Panels.Commits.IsRangeSelect() {
+		prompt = gui.Tr.SureSquashTheseCommits
+	}
+
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.Squash,
+			prompt: prompt,
+			handleConfirm: func() error {
+				return gui.WithWaitingStatus(gui.Tr.SquashingStatus, func() error {
+					err := gui.interactiveRebaseSelectedCommits(gui.Tr.Spans.SquashCommitDown, "squash")
+					return gui.handleGenericMergeCommandResult(err)
+				})
+			},
+		})
 	})
 }
 
@@ -195,15 +240,22 @@ func (gui *Gui) handleCommitFixup() error {
 		return nil
 	}
 
-	return gui.ask(askOpts{
-		title:  gui.Tr.Fixup,
-		prompt: gui.Tr.SureFixupThisCommit,
-		handleConfirm: func() error {
-			return gui.WithWaitingStatus(gui.Tr.FixingStatus, func() error {
-				err := gui.GitCommand.WithSpan(gui.Tr.Spans.FixupCommit).InteractiveRebase(gui.State.Commits, gui.State.Panels.Commits.SelectedLineIdx, "fixup")
-				return gui.handleGenericMergeCommandResult(err)
-			})
-		},
+	prompt := gui.Tr.SureFixupThisCommit
+	if gui.State.Panels.Commits.IsRangeSelect() {
+		prompt = gui.Tr.SureFixupTheseCommits
+	}
+
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.Fixup,
+			prompt: prompt,
+			handleConfirm: func() error {
+				return gui.WithWaitingStatus(gui.Tr.FixingStatus, func() error {
+					err := gui.interactiveRebaseSelectedCommits(gui.Tr.Spans.FixupCommit, "fixup")
+					return gui.handleGenericMergeCommandResult(err)
+				})
+			},
+		})
 	})
 }
 
@@ -229,21 +281,24 @@ func (gui *Gui) handleRenameCommit() error {
 		return nil
 	}
 
-	message, err := gui.GitCommand.GetCommitMessage(commit.Sha)
-	if err != nil {
-		return gui.surfaceError(err)
-	}
+	// amending HEAD rewrites the branch just like a rebase would
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		message, err := gui.GitCommand.GetCommitMessage(commit.Sha)
+		if err != nil {
+			return gui.surfaceError(err)
+		}
 
-	return gui.prompt(promptOpts{
-		title:          gui.Tr.LcRenameCommit,
-		initialContent: message,
-		handleConfirm: func(response string) error {
-			if err := gui.GitCommand.WithSpan(gui.Tr.Spans.RewordCommit).RenameCommit(response); err != nil {
-				return gui.surfaceError(err)
-			}
+		return gui.prompt(promptOpts{
+			title:          gui.Tr.LcRenameCommit,
+			initialContent: message,
+			handleConfirm: func(response string) error {
+				if err := gui.GitCommand.WithSpan(gui.Tr.Spans.RewordCommit).RenameCommit(response); err != nil {
+					return gui.surfaceError(err)
+				}
 
-			return gui.refreshSidePanels(refreshOptions{mode: ASYNC})
-		},
+				return gui.refreshSidePanels(refreshOptions{mode: ASYNC})
+			},
+		})
 	})
 }
 
@@ -260,25 +315,62 @@ func (gui *Gui) handleRenameCommitEditor() error {
 		return nil
 	}
 
-	subProcess, err := gui.GitCommand.WithSpan(gui.Tr.Spans.RewordCommit).RewordCommit(gui.State.Commits, gui.State.Panels.Commits.SelectedLineIdx)
-	if err != nil {
-		return gui.surfaceError(err)
-	}
-	if subProcess != nil {
-		return gui.runSubprocessWithSuspenseAndRefresh(subProcess)
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		subProcess, err := gui.GitCommand.WithSpan(gui.Tr.Spans.RewordCommit).RewordCommit(gui.State.Commits, gui.State.Panels.Commits.SelectedLineIdx)
+		if err != nil {
+			return gui.surfaceError(err)
+		}
+		if subProcess != nil {
+			return gui.runSubprocessWithSuspenseAndRefresh(subProcess)
+		}
+
+		return nil
+	})
+}
+
+// interactiveRebaseSelectedCommits applies the action to every selected commit
+func (gui *Gui) interactiveRebaseSelectedCommits(span string, action string) error {
+	start, end := gui.selectedCommitRange()
+	// the range is over once its commits have been rewritten
+	gui.State.Panels.Commits.CancelRangeSelect()
+
+	return gui.GitCommand.WithSpan(span).InteractiveRebaseRange(gui.State.Commits, start, end, action)
+}
+
+// selectedCommitRange returns the indices of the first and last selected commits
+func (gui *Gui) selectedCommitRange() (int, int) {
+	start, end := gui.State.Panels.Commits.GetSelectedLineRange()
+	if end > len(gui.State.Commits)-1 {
+		end = len(gui.State.Commits) - 1
 	}
 
-	return nil
+	return start, end
 }
 
 // handleMidRebaseCommand sees if the selected commit is in fact a rebasing
 // commit meaning you are trying to edit the todo file rather than actually
 // begin a rebase. It then updates the todo file with that action
 func (gui *Gui) handleMidRebaseCommand(action string) (bool, error) {
-	selectedCommit := gui.State.Commits[gui.State.Panels.Commits.SelectedLineIdx]
-	if selectedCommit.Status != "rebasing" {
+	start, end := gui.selectedCommitRange()
+	rebasingCount := 0
+	todoCommandCount := 0
+	for _, commit := range gui.State.Commits[start : end+1] {
+		if commit.Status == "rebasing" {
+			rebasingCount++
+		}
+		if commit.IsTodoCommand() {
+			todoCommandCount++
+		}
+	}
+	if rebasingCount == 0 {
 		return false, nil
 	}
+	if rebasingCount < end-start+1 {
+		return true, gui.createErrorPanel(gui.Tr.RangeSpansRebaseTodo)
+	}
+	if todoCommandCount > 0 {
+		return true, gui.createErrorPanel(gui.Tr.CantChangeTodoCommandAction)
+	}
 
 	// for now we do not support setting 'reword' because it requires an editor
 	// and that means we either unconditionally wait around for the subprocess to ask for
@@ -288,16 +380,22 @@ func (gui *Gui) handleMidRebaseCommand(action string) (bool, error) {
 		return true, gui.createErrorPanel(gui.Tr.LcRewordNotSupported)
 	}
 
-	gui.OnRunCommand(oscommands.NewCmdLogEntry(
-		fmt.Sprintf("Updating rebase action of commit %s to '%s'", selectedCommit.ShortSha(), action),
-		"Update rebase TODO",
-		false,
-	))
-
-	if err := gui.GitCommand.EditRebaseTodo(gui.State.Panels.Commits.SelectedLineIdx, action); err != nil {
+	if err := gui.snapshotRebaseTodo(); err != nil {
 		return false, gui.surfaceError(err)
 	}
 
+	for index := start; index <= end; index++ {
+		gui.OnRunCommand(oscommands.NewCmdLogEntry(
+			fmt.Sprintf("Updating rebase action of commit %s to '%s'", gui.State.Commits[index].ShortSha(), action),
+			"Update rebase TODO",
+			false,
+		))
+
+		if err := gui.GitCommand.EditRebaseTodo(index, action); err != nil {
+			return false, gui.surfaceError(err)
+		}
+	}
+
 	return true, gui.refreshRebaseCommits()
 }
 
@@ -314,15 +412,22 @@ func (gui *Gui) handleCommitDelete() error {
 		return nil
 	}
 
-	return gui.ask(askOpts{
-		title:  gui.Tr.DeleteCommitTitle,
-		prompt: gui.Tr.DeleteCommitPrompt,
-		handleConfirm: func() error {
-			return gui.WithWaitingStatus(gui.Tr.DeletingStatus, func() error {
-				err := gui.GitCommand.WithSpan(gui.Tr.Spans.DropCommit).InteractiveRebase(gui.State.Commits, gui.State.Panels.Commits.SelectedLineIdx, "drop")
-				return gui.handleGenericMergeCommandResult(err)
-			})
-		},
+	prompt := gui.Tr.DeleteCommitPrompt
+	if gui.State.Panels.Commits.IsRangeSelect() {
+		prompt = gui.Tr.DeleteCommitsPrompt
+	}
+
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.DeleteCommitTitle,
+			prompt: prompt,
+			handleConfirm: func() error {
+				return gui.WithWaitingStatus(gui.Tr.DeletingStatus, func() error {
+					err := gui.interactiveRebaseSelectedCommits(gui.Tr.Spans.DropCommit, "drop")
+					return gui.handleGenericMergeCommandResult(err)
+				})
+			},
+		})
 	})
 }
 
@@ -340,6 +445,10 @@ func (gui *Gui) handleCommitMoveDown() error {
 			return nil
 		}
 
+		if err := gui.snapshotRebaseTodo(); err != nil {
+			return gui.surfaceError(err)
+		}
+
 		// logging directly here because MoveTodoDown doesn't have enough information
 		// to provide a useful log
 		gui.OnRunCommand(oscommands.NewCmdLogEntry(
@@ -355,12 +464,14 @@ func (gui *Gui) handleCommitMoveDown() error {
 		return gui.refreshRebaseCommits()
 	}
 
-	return gui.WithWaitingStatus(gui.Tr.MovingStatus, func() error {
-		err := gui.GitCommand.WithSpan(span).MoveCommitDown(gui.State.Commits, index)
-		if err == nil {
-			gui.State.Panels.Commits.SelectedLineIdx++
-		}
-		return gui.handleGenericMergeCommandResult(err)
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.WithWaitingStatus(gui.Tr.MovingStatus, func() error {
+			err := gui.GitCommand.WithSpan(span).MoveCommitDown(gui.State.Commits, index)
+			if err == nil {
+				gui.State.Panels.Commits.SelectedLineIdx++
+			}
+			return gui.handleGenericMergeCommandResult(err)
+		})
 	})
 }
 
@@ -378,6 +489,10 @@ func (gui *Gui) handleCommitMoveUp() error {
 
 	selectedCommit := gui.State.Commits[index]
 	if selectedCommit.Status == "rebasing" {
+		if err := gui.snapshotRebaseTodo(); err != nil {
+			return gui.surfaceError(err)
+		}
+
 		// logging directly here because MoveTodoDown doesn't have enough information
 		// to provide a useful log
 		gui.OnRunCommand(oscommands.NewCmdLogEntry(
@@ -393,12 +508,14 @@ func (gui *Gui) handleCommitMoveUp() error {
 		return gui.refreshRebaseCommits()
 	}
 
-	return gui.WithWaitingStatus(gui.Tr.MovingStatus, func() error {
-		err := gui.GitCommand.WithSpan(span).MoveCommitDown(gui.State.Commits, index-1)
-		if err == nil {
-			gui.State.Panels.Commits.SelectedLineIdx--
-		}
-		return gui.handleGenericMergeCommandResult(err)
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.WithWaitingStatus(gui.Tr.MovingStatus, func() error {
+			err := gui.GitCommand.WithSpan(span).MoveCommitDown(gui.State.Commits, index-1)
+			if err == nil {
+				gui.State.Panels.Commits.SelectedLineIdx--
+			}
+			return gui.handleGenericMergeCommandResult(err)
+		})
 	})
 }
 
@@ -415,9 +532,11 @@ func (gui *Gui) handleCommitEdit() error {
 		return nil
 	}
 
-	return gui.WithWaitingStatus(gui.Tr.RebasingStatus, func() error {
-		err = gui.GitCommand.WithSpan(gui.Tr.Spans.EditCommit).InteractiveRebase(gui.State.Commits, gui.State.Panels.Commits.SelectedLineIdx, "edit")
-		return gui.handleGenericMergeCommandResult(err)
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.WithWaitingStatus(gui.Tr.RebasingStatus, func() error {
+			err = gui.GitCommand.WithSpan(gui.Tr.Spans.EditCommit).InteractiveRebase(gui.State.Commits, gui.State.Panels.Commits.SelectedLineIdx, "edit")
+			return gui.handleGenericMergeCommandResult(err)
+		})
 	})
 }
 
@@ -426,15 +545,17 @@ func (gui *Gui) handleCommitAmendTo() error {
 		return err
 	}
 
-	return gui.ask(askOpts{
-		title:  gui.Tr.AmendCommitTitle,
-		prompt: gui.Tr.AmendCommitPrompt,
-		handleConfirm: func() error {
-			return gui.WithWaitingStatus(gui.Tr.AmendingStatus, func() error {
-				err := gui.GitCommand.WithSpan(gui.Tr.Spans.AmendCommit).AmendTo(gui.State.Commits[gui.State.Panels.Commits.SelectedLineIdx].Sha)
-				return gui.handleGenericMergeCommandResult(err)
-			})
-		},
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.AmendCommitTitle,
+			prompt: gui.Tr.AmendCommitPrompt,
+			handleConfirm: func() error {
+				return gui.WithWaitingStatus(gui.Tr.AmendingStatus, func() error {
+					err := gui.GitCommand.WithSpan(gui.Tr.Spans.AmendCommit).AmendTo(gui.State.Commits[gui.State.Panels.Commits.SelectedLineIdx].Sha)
+					return gui.handleGenericMergeCommandResult(err)
+				})
+			},
+		})
 	})
 }
 
@@ -508,6 +629,10 @@ func (gui *Gui) handleViewCommitFiles() error {
 		return nil
 	}
 
+	if commit.Sha == "" {
+		return gui.createErrorPanel(gui.Tr.TodoCommandHasNoCommit)
+	}
+
 	return gui.switchToCommitFilesContext(commit.Sha, true, gui.State.Contexts.BranchCommits, "commits")
 }
 
@@ -558,39 +683,28 @@ func (gui *Gui) handleSquashAllAboveFixupCommits() error {
 		},
 	)
 
-	return gui.ask(askOpts{
-		title:  gui.Tr.SquashAboveCommits,
-		prompt: prompt,
-		handleConfirm: func() error {
-			return gui.WithWaitingStatus(gui.Tr.SquashingStatus, func() error {
-				err := gui.GitCommand.WithSpan(gui.Tr.Spans.SquashAllAboveFixupCommits).SquashAllAboveFixupCommits(commit.Sha)
-				return gui.handleGenericMergeCommandResult(err)
-			})
-		},
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.SquashAboveCommits,
+			prompt: prompt,
+			handleConfirm: func() error {
+				return gui.WithWaitingStatus(gui.Tr.SquashingStatus, func() error {
+					err := gui.GitCommand.WithSpan(gui.Tr.Spans.SquashAllAboveFixupCommits).SquashAllAboveFixupCommits(commit.Sha)
+					return gui.handleGenericMergeCommandResult(err)
+				})
+			},
+		})
 	})
 }
 
 func (gui *Gui) handleTagCommit() error {
-	// TODO: bring up menu asking if you want to make a lightweight or annotated tag
-	// if annotated, switch to a subprocess to create the message
-
 	commit := gui.getSelectedLocalCommit()
 	if commit == nil {
 		return nil
 	}
 
-	return gui.handleCreateLightweightTag(commit.Sha)
-}
-
-func (gui *Gui) handleCreateLightweightTag(commitSha string) error {
-	return gui.prompt(promptOpts{
-		title: gui.Tr.TagNameTitle,
-		handleConfirm: func(response string) error {
-			if err := gui.GitCommand.WithSpan(gui.Tr.Spans.CreateLightweightTag).CreateLightweightTag(response, commitSha); err != nil {
-				return gui.surfaceError(err)
-			}
-			return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{COMMITS, TAGS}})
-		},
+	return gui.handleCreateTagMenu(commit.Sha, func(string) error {
+		return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{COMMITS, TAGS}})
 	})
 }
 
@@ -18,12 +18,14 @@ const (
 	MAIN_MERGING_CONTEXT_KEY        ContextKey = "merging"
 	MAIN_PATCH_BUILDING_CONTEXT_KEY ContextKey = "patchBuilding"
 	MAIN_STAGING_CONTEXT_KEY        ContextKey = "staging"
+	MAIN_BLAME_CONTEXT_KEY          ContextKey = "blame"
 	MENU_CONTEXT_KEY                ContextKey = "menu"
 	CREDENTIALS_CONTEXT_KEY         ContextKey = "credentials"
 	CONFIRMATION_CONTEXT_KEY        ContextKey = "confirmation"
 	SEARCH_CONTEXT_KEY              ContextKey = "search"
 	COMMIT_MESSAGE_CONTEXT_KEY      ContextKey = "commitMessage"
 	SUBMODULES_CONTEXT_KEY          ContextKey = "submodules"
+	WORKTREES_CONTEXT_KEY           ContextKey = "worktrees"
 	SUGGESTIONS_CONTEXT_KEY         ContextKey = "suggestions"
 	COMMAND_LOG_CONTEXT_KEY         ContextKey = "cmdLog"
 )
@@ -44,12 +46,14 @@ var allContextKeys = []ContextKey{
 	MAIN_MERGING_CONTEXT_KEY,
 	MAIN_PATCH_BUILDING_CONTEXT_KEY,
 	MAIN_STAGING_CONTEXT_KEY,
+	MAIN_BLAME_CONTEXT_KEY,
 	MENU_CONTEXT_KEY,
 	CREDENTIALS_CONTEXT_KEY,
 	CONFIRMATION_CONTEXT_KEY,
 	SEARCH_CONTEXT_KEY,
 	COMMIT_MESSAGE_CONTEXT_KEY,
 	SUBMODULES_CONTEXT_KEY,
+	WORKTREES_CONTEXT_KEY,
 	SUGGESTIONS_CONTEXT_KEY,
 	COMMAND_LOG_CONTEXT_KEY,
 }
@@ -58,6 +62,7 @@ type ContextTree struct {
 	Status         Context
 	Files          *ListContext
 	Submodules     *ListContext
+	Worktrees      *ListContext
 	Menu           *ListContext
 	Branches       *ListContext
 	Remotes        *ListContext
@@ -73,6 +78,7 @@ type ContextTree struct {
 	Staging        Context
 	PatchBuilding  Context
 	Merging        Context
+	Blame          Context
 	Credentials    Context
 	Confirmation   Context
 	CommitMessage  Context
@@ -85,6 +91,7 @@ func (gui *Gui) allContexts() []Context {
 		gui.State.Contexts.Status,
 		gui.State.Contexts.Files,
 		gui.State.Contexts.Submodules,
+		gui.State.Contexts.Worktrees,
 		gui.State.Contexts.Branches,
 		gui.State.Contexts.Remotes,
 		gui.State.Contexts.RemoteBranches,
@@ -101,6 +108,7 @@ func (gui *Gui) allContexts() []Context {
 		gui.State.Contexts.Staging,
 		gui.State.Contexts.Merging,
 		gui.State.Contexts.PatchBuilding,
+		gui.State.Contexts.Blame,
 		gui.State.Contexts.SubCommits,
 		gui.State.Contexts.Suggestions,
 		gui.State.Contexts.CommandLog,
@@ -117,6 +125,7 @@ func (gui *Gui) contextTree() ContextTree {
 		},
 		Files:          gui.filesListContext(),
 		Submodules:     gui.submodulesListContext(),
+		Worktrees:      gui.worktreesListContext(),
 		Menu:           gui.menuListContext(),
 		Remotes:        gui.remotesListContext(),
 		RemoteBranches: gui.remoteBranchesListContext(),
@@ -162,6 +171,12 @@ func (gui *Gui) contextTree() ContextTree {
 			Key:             MAIN_MERGING_CONTEXT_KEY,
 			OnGetOptionsMap: gui.getMergingOptions,
 		},
+		Blame: &BasicContext{
+			OnFocus:  gui.renderBlame,
+			Kind:     MAIN_CONTEXT,
+			ViewName: "main",
+			Key:      MAIN_BLAME_CONTEXT_KEY,
+		},
 		Credentials: &BasicContext{
 			OnFocus:  gui.handleCredentialsViewFocused,
 			Kind:     PERSISTENT_POPUP,
@@ -261,6 +276,12 @@ func (tree ContextTree) initialViewTabContextMap() map[string][]tabContext {
 					tree.Submodules,
 				},
 			},
+			{
+				tab: "Worktrees",
+				contexts: []Context{
+					tree.Worktrees,
+				},
+			},
 		},
 	}
 }
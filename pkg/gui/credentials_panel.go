@@ -4,26 +4,73 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type credentials chan string
 
-// promptUserForCredential wait for a username, password or passphrase input from the credentials popup
-func (gui *Gui) promptUserForCredential(passOrUname string) string {
+// credentialSession answers the credential prompts of a single command, from
+// the credential cache if it's on. If the command fails, only the cached
+// answers it used are forgotten.
+type credentialSession struct {
+	gui   *Gui
+	cache *oscommands.CredentialSession
+	// when we can't ask the user, e.g. when fetching in the background, we
+	// answer from the credential cache or not at all
+	canAsk bool
+}
+
+func (gui *Gui) newCredentialSession(canAsk bool) *credentialSession {
+	return &credentialSession{
+		gui:    gui,
+		cache:  gui.credentialCache.NewSession(),
+		canAsk: canAsk,
+	}
+}
+
+// promptUserForCredential answers git or ssh's prompt, e.g. "Password for
+// 'https://github.com': ", for a command talking to the remote at remoteURL
+func (s *credentialSession) promptUserForCredential(remoteURL string, prompt string) string {
+	cacheCredentials := s.gui.Config.GetUserConfig().Git.CacheCredentials
+
+	if cacheCredentials {
+		if answer, ok := s.cache.Get(remoteURL, prompt); ok {
+			return answer
+		}
+	}
+
+	if !s.canAsk {
+		return ""
+	}
+
+	answer := s.gui.askUserForCredential(prompt)
+	if cacheCredentials {
+		s.cache.Set(remoteURL, prompt, answer)
+	}
+	return answer
+}
+
+// commandFinished is to be called with the command's error once it's finished
+func (s *credentialSession) commandFinished(err error) {
+	s.cache.CommandFinished(err)
+}
+
+// askUserForCredential waits for the user to answer the prompt in the
+// credentials popup
+func (gui *Gui) askUserForCredential(prompt string) string {
 	gui.credentials = make(chan string)
+
+	if isHostKeyPrompt(prompt) {
+		return gui.askUserToConfirmHostKey(prompt)
+	}
+
 	gui.g.Update(func(g *gocui.Gui) error {
 		credentialsView := gui.Views.Credentials
-		switch passOrUname {
-		case "username":
-			credentialsView.Title = gui.Tr.CredentialsUsername
+		credentialsView.Title = credentialTitle(prompt)
+		credentialsView.Mask = '*'
+		if isVisibleCredential(prompt) {
 			credentialsView.Mask = 0
-		case "password":
-			credentialsView.Title = gui.Tr.CredentialsPassword
-			credentialsView.Mask = '*'
-		default:
-			credentialsView.Title = gui.Tr.CredentialsPassphrase
-			credentialsView.Mask = '*'
 		}
 
 		if err := gui.pushContext(gui.State.Contexts.Credentials); err != nil {
@@ -35,8 +82,45 @@ func (gui *Gui) promptUserForCredential(passOrUname string) string {
 	})
 
 	// wait for username/passwords/passphrase input
-	userInput := <-gui.credentials
-	return userInput + "\n"
+	return <-gui.credentials
+}
+
+// askUserToConfirmHostKey shows the whole of ssh's prompt for a host it hasn't
+// seen before, which spans several lines so that it can tell you the host and
+// its key's fingerprint, and answers yes or no depending on whether the user
+// confirms it
+func (gui *Gui) askUserToConfirmHostKey(prompt string) string {
+	answer := func(response string) func() error {
+		return func() error {
+			gui.credentials <- response
+			return nil
+		}
+	}
+
+	_ = gui.ask(askOpts{
+		title:         gui.Tr.HostKeyPromptTitle,
+		prompt:        strings.TrimSpace(prompt),
+		handleConfirm: answer("yes"),
+		handleClose:   answer("no"),
+	})
+
+	return <-gui.credentials
+}
+
+func isHostKeyPrompt(prompt string) bool {
+	return strings.Contains(prompt, "(yes/no")
+}
+
+// credentialTitle turns a prompt like "Password for 'https://github.com': " into
+// the credentials popup's title
+func credentialTitle(prompt string) string {
+	return strings.TrimSuffix(strings.TrimSpace(prompt), ":")
+}
+
+// isVisibleCredential tells us whether the answer to the prompt can be shown
+// as it's typed. If in doubt we mask it.
+func isVisibleCredential(prompt string) bool {
+	return strings.HasPrefix(prompt, "Username")
 }
 
 func (gui *Gui) handleSubmitCredential() error {
@@ -75,7 +159,7 @@ func (gui *Gui) handleCredentialsViewFocused() error {
 func (gui *Gui) handleCredentialsPopup(cmdErr error) {
 	if cmdErr != nil {
 		errMessage := cmdErr.Error()
-		if strings.Contains(errMessage, "Invalid username, password or passphrase") {
+		if strings.Contains(errMessage, "Authentication failed") {
 			errMessage = gui.Tr.PassUnameWrong
 		}
 		_ = gui.returnFromContext()
@@ -85,3 +169,10 @@ func (gui *Gui) handleCredentialsPopup(cmdErr error) {
 		_ = gui.closeConfirmationPrompt(false)
 	}
 }
+
+func (gui *Gui) handleForgetCredentials() error {
+	gui.credentialCache.Clear()
+	gui.raiseToast(gui.Tr.CredentialsForgotten)
+
+	return nil
+}
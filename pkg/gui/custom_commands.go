@@ -204,8 +204,9 @@ func (gui *Gui) GetCustomCommandKeybindings() []*Binding {
 			Modifier:    gocui.ModNone,
 			Handler:     gui.handleCustomCommandKeybinding(customCommand),
 			Description: description,
+			Mutating:    true,
 		})
 	}
 
-	return bindings
+	return gui.guardMutatingBindings(bindings)
 }
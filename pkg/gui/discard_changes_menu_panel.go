@@ -1,6 +1,17 @@
 package gui
 
+import (
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
 func (gui *Gui) handleCreateDiscardMenu() error {
+	if gui.State.Panels.Files.IsRangeSelect() {
+		return gui.createDiscardRangeMenu(gui.getSelectedFileNodes())
+	}
+
 	node := gui.getSelectedFileNode()
 	if node == nil {
 		return nil
@@ -12,9 +23,19 @@ func (gui *Gui) handleCreateDiscardMenu() error {
 			{
 				displayString: gui.Tr.LcDiscardAllChanges,
 				onPress: func() error {
+					journalEntry, err := gui.newDiscardJournalEntry(node)
+					if err != nil {
+						return gui.surfaceError(err)
+					}
+					if err := gui.takeSafetySnapshot(gui.Tr.Spans.DiscardAllChangesInDirectory); err != nil {
+						return gui.surfaceError(err)
+					}
 					if err := gui.GitCommand.WithSpan(gui.Tr.Spans.DiscardAllChangesInDirectory).DiscardAllDirChanges(node); err != nil {
 						return gui.surfaceError(err)
 					}
+					if err := gui.journal(journalEntry); err != nil {
+						return gui.surfaceError(err)
+					}
 					return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
 				},
 			},
@@ -24,9 +45,16 @@ func (gui *Gui) handleCreateDiscardMenu() error {
 			menuItems = append(menuItems, &menuItem{
 				displayString: gui.Tr.LcDiscardUnstagedChanges,
 				onPress: func() error {
+					journalEntry, err := gui.newDiscardJournalEntry(node)
+					if err != nil {
+						return gui.surfaceError(err)
+					}
 					if err := gui.GitCommand.WithSpan(gui.Tr.Spans.DiscardUnstagedChangesInDirectory).DiscardUnstagedDirChanges(node); err != nil {
 						return gui.surfaceError(err)
 					}
+					if err := gui.journal(journalEntry); err != nil {
+						return gui.surfaceError(err)
+					}
 
 					return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
 				},
@@ -52,9 +80,19 @@ func (gui *Gui) handleCreateDiscardMenu() error {
 				{
 					displayString: gui.Tr.LcDiscardAllChanges,
 					onPress: func() error {
+						journalEntry, err := gui.newDiscardJournalEntry(node)
+						if err != nil {
+							return gui.surfaceError(err)
+						}
+						if err := gui.takeSafetySnapshot(gui.Tr.Spans.DiscardAllChangesInFile); err != nil {
+							return gui.surfaceError(err)
+						}
 						if err := gui.GitCommand.WithSpan(gui.Tr.Spans.DiscardAllChangesInFile).DiscardAllFileChanges(file); err != nil {
 							return gui.surfaceError(err)
 						}
+						if err := gui.journal(journalEntry); err != nil {
+							return gui.surfaceError(err)
+						}
 						return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
 					},
 				},
@@ -64,9 +102,16 @@ func (gui *Gui) handleCreateDiscardMenu() error {
 				menuItems = append(menuItems, &menuItem{
 					displayString: gui.Tr.LcDiscardUnstagedChanges,
 					onPress: func() error {
+						journalEntry, err := gui.newDiscardJournalEntry(node)
+						if err != nil {
+							return gui.surfaceError(err)
+						}
 						if err := gui.GitCommand.WithSpan(gui.Tr.Spans.DiscardAllUnstagedChangesInFile).DiscardUnstagedFileChanges(file); err != nil {
 							return gui.surfaceError(err)
 						}
+						if err := gui.journal(journalEntry); err != nil {
+							return gui.surfaceError(err)
+						}
 
 						return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
 					},
@@ -77,3 +122,68 @@ func (gui *Gui) handleCreateDiscardMenu() error {
 
 	return gui.createMenu(node.GetPath(), menuItems, createMenuOptions{showCancel: true})
 }
+
+// createDiscardRangeMenu offers to discard changes in every selected file or
+// directory at once
+func (gui *Gui) createDiscardRangeMenu(nodes []*filetree.FileNode) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	anyStagedAndUnstaged := false
+	for _, node := range nodes {
+		if node.IsLeaf() && node.File.IsSubmodule(gui.State.Submodules) {
+			return gui.createErrorPanel(gui.Tr.ErrDiscardRangeWithSubmodule)
+		}
+		if node.GetHasStagedChanges() && node.GetHasUnstagedChanges() {
+			anyStagedAndUnstaged = true
+		}
+	}
+
+	discardEach := func(discard func(node *filetree.FileNode) error) error {
+		journalEntry, err := gui.newDiscardJournalEntry(nodes...)
+		if err != nil {
+			return gui.surfaceError(err)
+		}
+		for i, node := range nodes {
+			if err := discard(node); err != nil {
+				// the nodes we got through are discarded, so undo should bring them back
+				if i > 0 {
+					_ = gui.journal(journalEntry)
+				}
+				return gui.surfaceError(err)
+			}
+		}
+		if err := gui.journal(journalEntry); err != nil {
+			return gui.surfaceError(err)
+		}
+		// the discarded files are likely gone from the list, so the range is too
+		gui.State.Panels.Files.CancelRangeSelect()
+		return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
+	}
+
+	menuItems := []*menuItem{
+		{
+			displayString: gui.Tr.LcDiscardAllChanges,
+			onPress: func() error {
+				if err := gui.takeSafetySnapshot(gui.Tr.Spans.DiscardAllChangesInRange); err != nil {
+					return gui.surfaceError(err)
+				}
+				return discardEach(gui.GitCommand.WithSpan(gui.Tr.Spans.DiscardAllChangesInRange).DiscardAllDirChanges)
+			},
+		},
+	}
+
+	if anyStagedAndUnstaged {
+		menuItems = append(menuItems, &menuItem{
+			displayString: gui.Tr.LcDiscardUnstagedChanges,
+			onPress: func() error {
+				return discardEach(gui.GitCommand.WithSpan(gui.Tr.Spans.DiscardUnstagedChangesInRange).DiscardUnstagedDirChanges)
+			},
+		})
+	}
+
+	title := utils.ResolvePlaceholderString(gui.Tr.DiscardRangeTitle, map[string]string{"count": strconv.Itoa(len(nodes))})
+
+	return gui.createMenu(title, menuItems, createMenuOptions{showCancel: true})
+}
@@ -3,6 +3,7 @@ package gui
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -25,6 +26,34 @@ func (gui *Gui) getSelectedFileNode() *filetree.FileNode {
 	return gui.State.FileManager.GetItemAtIndex(selectedLine)
 }
 
+// getSelectedFileNodes returns every node in the selected range. Nodes inside a
+// selected directory are left out, given that acting on the directory acts on
+// them too.
+func (gui *Gui) getSelectedFileNodes() []*filetree.FileNode {
+	start, end := gui.State.Panels.Files.GetSelectedLineRange()
+
+	nodes := []*filetree.FileNode{}
+	for i := start; i <= end; i++ {
+		node := gui.State.FileManager.GetItemAtIndex(i)
+		if node == nil || isWithinAnyDir(node, nodes) {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+func isWithinAnyDir(node *filetree.FileNode, others []*filetree.FileNode) bool {
+	for _, other := range others {
+		if !other.IsLeaf() && strings.HasPrefix(node.GetPath(), other.GetPath()+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (gui *Gui) getSelectedFile() *models.File {
 	node := gui.getSelectedFileNode()
 	if node == nil {
@@ -206,6 +235,10 @@ func (gui *Gui) enterFile(forceSecondaryFocused bool, selectedLineIdx int) error
 }
 
 func (gui *Gui) handleFilePress() error {
+	if gui.State.Panels.Files.IsRangeSelect() {
+		return gui.toggleStagedForFileNodes(gui.getSelectedFileNodes())
+	}
+
 	node := gui.getSelectedFileNode()
 	if node == nil {
 		return nil
@@ -253,6 +286,41 @@ func (gui *Gui) handleFilePress() error {
 	return gui.selectFile(true)
 }
 
+// toggleStagedForFileNodes stages all of the given nodes if any of them have
+// unstaged changes, and otherwise unstages them all
+func (gui *Gui) toggleStagedForFileNodes(nodes []*filetree.FileNode) error {
+	anyUnstaged := false
+	for _, node := range nodes {
+		// staging the >>>>>> lines of a conflicted file would mark it resolved
+		if node.GetHasInlineMergeConflicts() {
+			return gui.createErrorPanel(gui.Tr.ErrStageRangeConflicts)
+		}
+		if node.GetHasUnstagedChanges() {
+			anyUnstaged = true
+		}
+	}
+
+	for _, node := range nodes {
+		var err error
+		if anyUnstaged {
+			err = gui.GitCommand.WithSpan(gui.Tr.Spans.StageFile).StageFile(node.GetPath())
+		} else if node.IsLeaf() {
+			err = gui.GitCommand.WithSpan(gui.Tr.Spans.UnstageFile).UnStageFile(node.File.Names(), node.File.Tracked)
+		} else {
+			err = gui.GitCommand.WithSpan(gui.Tr.Spans.UnstageFile).UnStageFile([]string{node.GetPath()}, true)
+		}
+		if err != nil {
+			return gui.surfaceError(err)
+		}
+	}
+
+	if err := gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{FILES}}); err != nil {
+		return err
+	}
+
+	return gui.selectFile(true)
+}
+
 func (gui *Gui) allFilesStaged() bool {
 	for _, file := range gui.State.FileManager.GetAllFiles() {
 		if file.HasUnstagedChanges {
@@ -391,7 +459,9 @@ func (gui *Gui) handleCommitPress() error {
 	}
 
 	commitPrefixConfig := gui.commitPrefixConfigForRepo()
-	if commitPrefixConfig != nil {
+	if gui.State.Modes.Splitting.Active() {
+		gui.prefillSplitCommitMessage()
+	} else if commitPrefixConfig != nil {
 		prefixPattern := commitPrefixConfig.Pattern
 		prefixReplace := commitPrefixConfig.Replace
 		rgx, err := regexp.Compile(prefixPattern)
@@ -468,9 +538,18 @@ func (gui *Gui) handleCommitEditorPress() error {
 		return gui.promptToStageAllAndRetry(gui.handleCommitEditorPress)
 	}
 
-	return gui.runSubprocessWithSuspenseAndRefresh(
+	headSha := ""
+	if gui.State.Modes.Splitting.Active() {
+		headSha, _ = gui.GitCommand.GetHeadSha()
+	}
+
+	if err := gui.runSubprocessWithSuspenseAndRefresh(
 		gui.OSCommand.WithSpan(gui.Tr.Spans.Commit).PrepareSubProcess("git", "commit"),
-	)
+	); err != nil {
+		return err
+	}
+
+	return gui.afterSplitCommitWithEditor(headSha)
 }
 
 func (gui *Gui) editFile(filename string) error {
@@ -674,13 +753,15 @@ func (gui *Gui) pullWithMode(mode string, opts PullFilesOptions) error {
 
 	gitCommand := gui.GitCommand.WithSpan(opts.span)
 
+	credentials := gui.newCredentialSession(true)
 	err := gitCommand.Fetch(
 		commands.FetchOptions{
-			PromptUserForCredential: gui.promptUserForCredential,
+			PromptUserForCredential: credentials.promptUserForCredential,
 			RemoteName:              opts.RemoteName,
 			BranchName:              opts.BranchName,
 		},
 	)
+	credentials.commandFinished(err)
 	gui.handleCredentialsPopup(err)
 	if err != nil {
 		return gui.refreshSidePanels(refreshOptions{mode: ASYNC})
@@ -707,22 +788,19 @@ func (gui *Gui) pushWithForceFlag(force bool, upstream string, args string) erro
 	}
 	go utils.Safe(func() {
 		branchName := gui.getCheckedOutBranch().Name
-		err := gui.GitCommand.WithSpan(gui.Tr.Spans.Push).Push(branchName, force, upstream, args, gui.promptUserForCredential)
+		credentials := gui.newCredentialSession(true)
+		err := gui.GitCommand.WithSpan(gui.Tr.Spans.Push).Push(branchName, force, upstream, args, credentials.promptUserForCredential)
 		if err != nil && !force && strings.Contains(err.Error(), "Updates were rejected") {
-			forcePushDisabled := gui.Config.GetUserConfig().Git.DisableForcePushing
-			if forcePushDisabled {
+			// the remote accepted our credentials before rejecting the push
+			credentials.commandFinished(nil)
+			if gui.branchProtection(branchName, FORCE_PUSH) == config.PROTECTION_BLOCK {
 				_ = gui.createErrorPanel(gui.Tr.UpdatesRejectedAndForcePushDisabled)
 				return
 			}
-			_ = gui.ask(askOpts{
-				title:  gui.Tr.ForcePush,
-				prompt: gui.Tr.ForcePushPrompt,
-				handleConfirm: func() error {
-					return gui.pushWithForceFlag(true, upstream, args)
-				},
-			})
+			_ = gui.requestToForcePush(branchName, upstream, args)
 			return
 		}
+		credentials.commandFinished(err)
 		gui.handleCredentialsPopup(err)
 		_ = gui.refreshSidePanels(refreshOptions{mode: ASYNC})
 	})
@@ -743,7 +821,7 @@ func (gui *Gui) pushFiles() error {
 
 	if currentBranch.IsTrackingRemote() {
 		if currentBranch.HasCommitsToPull() {
-			return gui.requestToForcePush()
+			return gui.requestToForcePush(currentBranch.Name, "", "")
 		} else {
 			return gui.pushWithForceFlag(false, "", "")
 		}
@@ -772,21 +850,52 @@ func (gui *Gui) pushFiles() error {
 	}
 }
 
-func (gui *Gui) requestToForcePush() error {
-	forcePushDisabled := gui.Config.GetUserConfig().Git.DisableForcePushing
-	if forcePushDisabled {
+func (gui *Gui) requestToForcePush(branchName string, upstream string, args string) error {
+	if gui.branchProtection(branchName, FORCE_PUSH) == config.PROTECTION_BLOCK {
 		return gui.createErrorPanel(gui.Tr.ForcePushDisabled)
 	}
 
 	return gui.ask(askOpts{
 		title:  gui.Tr.ForcePush,
-		prompt: gui.Tr.ForcePushPrompt,
+		prompt: gui.forcePushPrompt(branchName, upstream),
 		handleConfirm: func() error {
-			return gui.pushWithForceFlag(true, "", "")
+			return gui.withBranchProtection(branchName, FORCE_PUSH, func() error {
+				return gui.pushWithForceFlag(true, upstream, args)
+			})
 		},
 	})
 }
 
+// we don't want a huge list of commits in the force push prompt
+const maxForcePushPreviewCommits = 20
+
+// forcePushPrompt lists the remote commits that force pushing would overwrite,
+// falling back to a generic prompt if we can't work those out
+func (gui *Gui) forcePushPrompt(branchName string, upstream string) string {
+	commits, err := gui.GitCommand.GetCommitsOverwrittenByForcePush(branchName, upstream)
+	if err != nil {
+		gui.Log.Error(err)
+		return gui.Tr.ForcePushPrompt
+	}
+
+	if len(commits) == 0 {
+		return gui.Tr.ForcePushPrompt
+	}
+
+	if len(commits) > maxForcePushPreviewCommits {
+		more := utils.ResolvePlaceholderString(
+			gui.Tr.AndMoreCommits,
+			map[string]string{"count": strconv.Itoa(len(commits) - maxForcePushPreviewCommits)},
+		)
+		commits = append(commits[:maxForcePushPreviewCommits], more)
+	}
+
+	return utils.ResolvePlaceholderString(
+		gui.Tr.ForcePushOverwritesCommitsPrompt,
+		map[string]string{"commits": strings.Join(commits, "\n")},
+	)
+}
+
 func (gui *Gui) upstreamForBranchInConfig(branchName string) (string, error) {
 	conf, err := gui.GitCommand.Repo.Config()
 	if err != nil {
@@ -2,7 +2,13 @@ package gui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 func (gui *Gui) handleCreateFilteringMenuPanel() error {
@@ -22,6 +28,23 @@ func (gui *Gui) handleCreateFilteringMenuPanel() error {
 
 	menuItems := []*menuItem{}
 
+	// we leave out the option if the selected lines have no history to view
+	if filter, err := gui.selectedLineRangeFilter(); err == nil && filter != nil {
+		menuItems = append(menuItems, &menuItem{
+			displayString: utils.ResolvePlaceholderString(
+				gui.Tr.LcViewLineRangeHistory,
+				map[string]string{
+					"firstLine": strconv.Itoa(filter.firstLine),
+					"lastLine":  strconv.Itoa(filter.lastLine),
+					"path":      filter.path,
+				},
+			),
+			onPress: func() error {
+				return gui.viewLineRangeHistory(filter)
+			},
+		})
+	}
+
 	if fileName != "" {
 		menuItems = append(menuItems, &menuItem{
 			displayString: fmt.Sprintf("%s '%s'", gui.Tr.LcFilterBy, fileName),
@@ -52,3 +75,79 @@ func (gui *Gui) handleCreateFilteringMenuPanel() error {
 
 	return gui.createMenu(gui.Tr.FilteringMenuTitle, menuItems, createMenuOptions{showCancel: true})
 }
+
+// lineRangeFilter is a range of lines in a file, as selected in the staging or
+// patch-building view
+type lineRangeFilter struct {
+	path      string
+	firstLine int
+	lastLine  int
+	// the ref whose version of the file the line numbers refer to
+	ref string
+}
+
+func (gui *Gui) selectedLineRangeFilter() (*lineRangeFilter, error) {
+	var filter *lineRangeFilter
+
+	err := gui.withLBLActiveCheck(func(state *LblPanelState) error {
+		switch gui.currentContext().GetKey() {
+		case MAIN_STAGING_CONTEXT_KEY:
+			file := gui.getSelectedFile()
+			if file == nil {
+				return nil
+			}
+
+			// git log -L needs line numbers from a committed version of the file,
+			// so we take them from the old side of the diff. For staged changes
+			// that's HEAD's version, but for unstaged changes it's the index's
+			// version, so if the file also has staged changes we map the lines
+			// back onto HEAD's version through the staged diff.
+			firstLine, lastLine, ok := state.SelectedLineNumberRange(true)
+			if !ok {
+				return errors.New(gui.Tr.LineRangeHasNoHistory)
+			}
+			if !state.SecondaryFocused && file.HasStagedChanges {
+				stagedDiff := gui.GitCommand.WorktreeFileDiff(file, true, true, false)
+				firstLine, lastLine, ok = patch.OldLineNumberRange(stagedDiff, firstLine, lastLine)
+				if !ok {
+					return errors.New(gui.Tr.LineRangeHasNoHistory)
+				}
+			}
+
+			filter = &lineRangeFilter{path: file.Name, firstLine: firstLine, lastLine: lastLine, ref: "HEAD"}
+		case MAIN_PATCH_BUILDING_CONTEXT_KEY:
+			firstLine, lastLine, ok := state.SelectedLineNumberRange(false)
+			if !ok {
+				return errors.New(gui.Tr.LineRangeHasNoHistory)
+			}
+			filter = &lineRangeFilter{
+				path:      gui.getSelectedCommitFileName(),
+				firstLine: firstLine,
+				lastLine:  lastLine,
+				ref:       gui.State.Panels.CommitFiles.refName,
+			}
+		}
+
+		return nil
+	})
+
+	return filter, err
+}
+
+// viewLineRangeHistory shows the commits that touched the given lines, in the
+// same way that we show a branch's commits
+func (gui *Gui) viewLineRangeHistory(filter *lineRangeFilter) error {
+	err := gui.switchToSubCommitsContextWithOpts(
+		commands.GetCommitsOptions{
+			Limit:           gui.State.Panels.Commits.LimitCommits,
+			FilterPath:      filter.path,
+			FilterLineRange: fmt.Sprintf("%d,%d", filter.firstLine, filter.lastLine),
+			RefName:         filter.ref,
+		},
+	)
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	return nil
+}
@@ -191,12 +191,11 @@ func (gui *Gui) fetch(canPromptForCredentials bool, span string) (err error) {
 	gui.Mutexes.FetchMutex.Lock()
 	defer gui.Mutexes.FetchMutex.Unlock()
 
-	fetchOpts := commands.FetchOptions{}
-	if canPromptForCredentials {
-		fetchOpts.PromptUserForCredential = gui.promptUserForCredential
-	}
+	credentials := gui.newCredentialSession(canPromptForCredentials)
+	fetchOpts := commands.FetchOptions{PromptUserForCredential: credentials.promptUserForCredential}
 
 	err = gui.GitCommand.WithSpan(span).Fetch(fetchOpts)
+	credentials.commandFinished(err)
 
 	if canPromptForCredentials && err != nil && strings.Contains(err.Error(), "exit status 128") {
 		_ = gui.createErrorPanel(gui.Tr.PassUnameWrong)
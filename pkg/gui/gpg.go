@@ -1,11 +1,21 @@
 package gui
 
+import (
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+)
+
 // Currently there is a bug where if we switch to a subprocess from within
 // WithWaitingStatus we get stuck there and can't return to lazygit. We could
 // fix this bug, or just stop running subprocesses from within there, given that
 // we don't need to see a loading status if we're in a subprocess.
 func (gui *Gui) withGpgHandling(cmdStr string, waitingStatus string, onSuccess func() error) error {
-	useSubprocess := gui.GitCommand.UsingGpg()
+	return gui.withGpgHandlingIf(gui.GitCommand.UsingGpg(), cmdStr, waitingStatus, onSuccess)
+}
+
+// withGpgHandlingIf is for commands other than committing, where whether gpg
+// gets involved depends on something other than the commit.gpgsign config
+func (gui *Gui) withGpgHandlingIf(useSubprocess bool, cmdStr string, waitingStatus string, onSuccess func() error) error {
 	if useSubprocess {
 		// Need to remember why we use the shell for the subprocess but not in the other case
 		// Maybe there's no good reason
@@ -39,3 +49,31 @@ func (gui *Gui) withGpgHandling(cmdStr string, waitingStatus string, onSuccess f
 
 	return nil
 }
+
+// runCommitCommand runs a command that creates a commit, in a subprocess if gpg
+// might need to ask for a passphrase. We can't tell whether a subprocess
+// succeeded, so in that case we check that HEAD has moved instead.
+func (gui *Gui) runCommitCommand(gitCommand *commands.GitCommand, cmdStr string) error {
+	if !gitCommand.UsingGpg() {
+		return gitCommand.RunCommand(cmdStr)
+	}
+
+	headSha, err := gitCommand.GetHeadSha()
+	if err != nil {
+		return err
+	}
+
+	if _, err := gui.runSubprocessWithSuspense(gitCommand.OSCommand.ShellCommandFromString(cmdStr)); err != nil {
+		return err
+	}
+
+	newHeadSha, err := gitCommand.GetHeadSha()
+	if err != nil {
+		return err
+	}
+	if newHeadSha == headSha {
+		return errors.New(gui.Tr.CommitNotCreated)
+	}
+
+	return nil
+}
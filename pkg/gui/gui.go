@@ -21,9 +21,12 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/lbl"
 	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/bisecting"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/rebaseonto"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/splitting"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
@@ -87,6 +90,7 @@ type Gui struct {
 	Updater              *updates.Updater
 	statusManager        *statusManager
 	credentials          credentials
+	credentialCache      *oscommands.CredentialCache
 	waitForIntro         sync.WaitGroup
 	fileWatcher          *fileWatcher
 	viewBufferManagerMap map[string]*tasks.ViewBufferManager
@@ -126,6 +130,11 @@ type Gui struct {
 
 type listPanelState struct {
 	SelectedLineIdx int
+
+	// when range selecting, the range runs from here to the selected line (in
+	// either direction)
+	RangeStartIdx   int
+	RangeSelectMode bool
 }
 
 func (h *listPanelState) SetSelectedLineIdx(value int) {
@@ -136,6 +145,37 @@ func (h *listPanelState) GetSelectedLineIdx() int {
 	return h.SelectedLineIdx
 }
 
+func (h *listPanelState) StartRangeSelect() {
+	if h.RangeSelectMode {
+		return
+	}
+
+	h.RangeSelectMode = true
+	h.RangeStartIdx = h.SelectedLineIdx
+}
+
+func (h *listPanelState) CancelRangeSelect() {
+	h.RangeSelectMode = false
+}
+
+func (h *listPanelState) IsRangeSelect() bool {
+	return h.RangeSelectMode
+}
+
+// GetSelectedLineRange returns the first and last selected indices, which are
+// the same unless we're range selecting
+func (h *listPanelState) GetSelectedLineRange() (int, int) {
+	if !h.RangeSelectMode {
+		return h.SelectedLineIdx, h.SelectedLineIdx
+	}
+
+	if h.RangeStartIdx < h.SelectedLineIdx {
+		return h.RangeStartIdx, h.SelectedLineIdx
+	}
+
+	return h.SelectedLineIdx, h.RangeStartIdx
+}
+
 // for now the staging panel state, unlike the other panel states, is going to be
 // non-mutative, so that we don't accidentally end up
 // with mismatches of data. We might change this in the future
@@ -152,6 +192,17 @@ type MergingPanelState struct {
 	UserScrolling bool
 }
 
+type BlamePanelState struct {
+	Lines           []*models.BlameLine
+	SelectedLineIdx int
+	Filename        string
+	Ref             string // empty when blaming the working tree
+
+	// the blame we came from when stepping back to a parent commit, so that we
+	// can step forward again
+	Previous *BlamePanelState
+}
+
 type filePanelState struct {
 	listPanelState
 }
@@ -188,6 +239,9 @@ type subCommitPanelState struct {
 
 	// e.g. name of branch whose commits we're looking at
 	refName string
+
+	// the file whose history we're looking at, if any
+	filterPath string
 }
 
 type stashPanelState struct {
@@ -212,6 +266,10 @@ type submodulePanelState struct {
 	listPanelState
 }
 
+type worktreePanelState struct {
+	listPanelState
+}
+
 type suggestionsPanelState struct {
 	listPanelState
 }
@@ -229,8 +287,10 @@ type panelStates struct {
 	Menu           *menuPanelState
 	LineByLine     *LblPanelState
 	Merging        *MergingPanelState
+	Blame          *BlamePanelState
 	CommitFiles    *commitFilesPanelState
 	Submodules     *submodulePanelState
+	Worktrees      *worktreePanelState
 	Suggestions    *suggestionsPanelState
 }
 
@@ -275,6 +335,9 @@ type Modes struct {
 	Filtering     filtering.Filtering
 	CherryPicking cherrypicking.CherryPicking
 	Diffing       diffing.Diffing
+	Bisecting     bisecting.Bisecting
+	Splitting     splitting.Splitting
+	RebaseOnto    rebaseonto.RebaseOnto
 }
 
 type guiMutexes struct {
@@ -284,6 +347,7 @@ type guiMutexes struct {
 	BranchCommitsMutex    sync.Mutex
 	LineByLinePanelMutex  sync.Mutex
 	SubprocessMutex       sync.Mutex
+	PullRequestsMutex     sync.Mutex
 }
 
 type guiState struct {
@@ -292,9 +356,13 @@ type guiState struct {
 	FileManager       *filetree.FileManager
 	CommitFileManager *filetree.CommitFileManager
 	Submodules        []*models.SubmoduleConfig
+	Worktrees         []*models.Worktree
 	Branches          []*models.Branch
-	Commits           []*models.Commit
-	StashEntries      []*models.StashEntry
+	// PullRequests are only populated when git.pullRequestStatus is on. They're
+	// refreshed in the background, so they're guarded by a mutex.
+	PullRequests pullRequestsState
+	Commits      []*models.Commit
+	StashEntries []*models.StashEntry
 	// Suggestions will sometimes appear when typing into a prompt
 	Suggestions []*types.Suggestion
 	// FilteredReflogCommits are the ones that appear in the reflog panel.
@@ -342,6 +410,10 @@ type guiState struct {
 
 	// flag as to whether or not the diff view should ignore whitespace
 	IgnoreWhitespaceInDiffView bool
+
+	// snapshots of the rebase's TODO file from before each of our edits to it,
+	// so that those edits can be undone mid-rebase
+	RebaseTodoHistory rebaseTodoHistory
 }
 
 // reuseState determines if we pull the repo state from our repo state map or
@@ -390,6 +462,7 @@ func (gui *Gui) resetState(filterPath string, reuseState bool) {
 			// TODO: work out why some of these are -1 and some are 0. Last time I checked there was a good reason but I'm less certain now
 			Files:          &filePanelState{listPanelState{SelectedLineIdx: -1}},
 			Submodules:     &submodulePanelState{listPanelState{SelectedLineIdx: -1}},
+			Worktrees:      &worktreePanelState{listPanelState{SelectedLineIdx: -1}},
 			Branches:       &branchPanelState{listPanelState{SelectedLineIdx: 0}},
 			Remotes:        &remotePanelState{listPanelState{SelectedLineIdx: 0}},
 			RemoteBranches: &remoteBranchesState{listPanelState{SelectedLineIdx: -1}},
@@ -411,6 +484,9 @@ func (gui *Gui) resetState(filterPath string, reuseState bool) {
 			Filtering:     filtering.New(filterPath),
 			CherryPicking: cherrypicking.New(),
 			Diffing:       diffing.New(),
+			Bisecting:     bisecting.New(),
+			Splitting:     splitting.New(),
+			RebaseOnto:    rebaseonto.New(),
 		},
 		ViewContextMap:    contexts.initialViewContextMap(),
 		ViewTabContextMap: contexts.initialViewTabContextMap(),
@@ -434,6 +510,7 @@ func NewGui(log *logrus.Entry, gitCommand *commands.GitCommand, oSCommand *oscom
 		Tr:                   tr,
 		Updater:              updater,
 		statusManager:        &statusManager{},
+		credentialCache:      oscommands.NewCredentialCache(),
 		viewBufferManagerMap: map[string]*tasks.ViewBufferManager{},
 		showRecentRepos:      showRecentRepos,
 		RepoPathStack:        []string{},
@@ -510,10 +587,15 @@ func (gui *Gui) Run() error {
 	}
 
 	gui.waitForIntro.Add(1)
-	if gui.Config.GetUserConfig().Git.AutoFetch {
+	// fetching updates the remote branches so we don't do it in read-only mode
+	if gui.Config.GetUserConfig().Git.AutoFetch && !gui.Config.GetReadOnly() {
 		go utils.Safe(gui.startBackgroundFetch)
 	}
 
+	if userConfig.Git.PullRequestStatus.Enabled {
+		go utils.Safe(gui.startPullRequestStatusRefresh)
+	}
+
 	gui.goEvery(time.Second*time.Duration(userConfig.Refresher.RefreshInterval), gui.stopChan, gui.refreshFilesAndSubmodules)
 
 	g.SetManager(gocui.ManagerFunc(gui.layout), gocui.ManagerFunc(gui.getFocusLayout()))
@@ -24,6 +24,9 @@ type Binding struct {
 	Alternative string
 	Tag         string // e.g. 'navigation'. Used for grouping things in the cheatsheet
 	OpensMenu   bool
+	// Mutating bindings change the repo in some way, so they're disabled in
+	// read-only mode
+	Mutating bool
 }
 
 // GetDisplayStrings returns the display string of a file
@@ -280,6 +283,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "",
 			Key:         gui.getKey(config.Universal.CreateRebaseOptionsMenu),
 			Handler:     gui.handleCreateRebaseOptionsMenu,
+			Mutating:    true,
 			Description: gui.Tr.ViewMergeRebaseOptions,
 			OpensMenu:   true,
 		},
@@ -287,6 +291,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "",
 			Key:         gui.getKey(config.Universal.CreatePatchOptionsMenu),
 			Handler:     gui.handleCreatePatchOptionsMenu,
+			Mutating:    true,
 			Description: gui.Tr.ViewPatchOptions,
 			OpensMenu:   true,
 		},
@@ -294,12 +299,14 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "",
 			Key:         gui.getKey(config.Universal.PushFiles),
 			Handler:     gui.pushFiles,
+			Mutating:    true,
 			Description: gui.Tr.LcPush,
 		},
 		{
 			ViewName:    "",
 			Key:         gui.getKey(config.Universal.PullFiles),
 			Handler:     gui.handlePullFiles,
+			Mutating:    true,
 			Description: gui.Tr.LcPull,
 		},
 		{
@@ -331,12 +338,14 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "",
 			Key:         gui.getKey(config.Universal.Undo),
 			Handler:     gui.reflogUndo,
+			Mutating:    true,
 			Description: gui.Tr.LcUndoReflog,
 		},
 		{
 			ViewName:    "",
 			Key:         gui.getKey(config.Universal.Redo),
 			Handler:     gui.reflogRedo,
+			Mutating:    true,
 			Description: gui.Tr.LcRedoReflog,
 		},
 		{
@@ -381,11 +390,18 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleShowAllBranchLogs,
 			Description: gui.Tr.LcAllBranchesLogGraph,
 		},
+		{
+			ViewName:    "status",
+			Key:         gui.getKey(config.Status.ForgetCredentials),
+			Handler:     gui.handleForgetCredentials,
+			Description: gui.Tr.LcForgetCredentials,
+		},
 		{
 			ViewName:    "files",
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.CommitChanges),
 			Handler:     gui.handleCommitPress,
+			Mutating:    true,
 			Description: gui.Tr.CommitChanges,
 		},
 		{
@@ -393,6 +409,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.CommitChangesWithoutHook),
 			Handler:     gui.handleWIPCommitPress,
+			Mutating:    true,
 			Description: gui.Tr.LcCommitChangesWithoutHook,
 		},
 		{
@@ -400,6 +417,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.AmendLastCommit),
 			Handler:     gui.handleAmendCommitPress,
+			Mutating:    true,
 			Description: gui.Tr.AmendLastCommit,
 		},
 		{
@@ -407,6 +425,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.CommitChangesWithEditor),
 			Handler:     gui.handleCommitEditorPress,
+			Mutating:    true,
 			Description: gui.Tr.CommitChangesWithEditor,
 		},
 		{
@@ -414,6 +433,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.handleFilePress,
+			Mutating:    true,
 			Description: gui.Tr.LcToggleStaged,
 		},
 		{
@@ -421,6 +441,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleCreateDiscardMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewDiscardOptions,
 			OpensMenu:   true,
 		},
@@ -429,6 +450,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Edit),
 			Handler:     gui.handleFileEdit,
+			Mutating:    true,
 			Description: gui.Tr.LcEditFile,
 		},
 		{
@@ -443,6 +465,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.IgnoreFile),
 			Handler:     gui.handleIgnoreFile,
+			Mutating:    true,
 			Description: gui.Tr.LcIgnoreFile,
 		},
 		{
@@ -457,6 +480,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.StashAllChanges),
 			Handler:     gui.handleStashChanges,
+			Mutating:    true,
 			Description: gui.Tr.LcStashAllChanges,
 		},
 		{
@@ -464,6 +488,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.ViewStashOptions),
 			Handler:     gui.handleCreateStashMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewStashOptions,
 			OpensMenu:   true,
 		},
@@ -472,6 +497,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.ToggleStagedAll),
 			Handler:     gui.handleStageAll,
+			Mutating:    true,
 			Description: gui.Tr.LcToggleStagedAll,
 		},
 		{
@@ -479,6 +505,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.ViewResetOptions),
 			Handler:     gui.handleCreateResetMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
@@ -494,6 +521,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.Fetch),
 			Handler:     gui.handleGitFetch,
+			Mutating:    true,
 			Description: gui.Tr.LcFetch,
 		},
 		{
@@ -507,6 +535,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "",
 			Key:         gui.getKey(config.Universal.ExecuteCustomCommand),
 			Handler:     gui.handleCustomCommand,
+			Mutating:    true,
 			Description: gui.Tr.LcExecuteCustomCommand,
 		},
 		{
@@ -514,6 +543,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.ViewResetOptions),
 			Handler:     gui.handleCreateResetToUpstreamMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetToUpstreamOptions,
 			OpensMenu:   true,
 		},
@@ -529,13 +559,38 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.OpenMergeTool),
 			Handler:     gui.handleOpenMergeTool,
+			Mutating:    true,
 			Description: gui.Tr.LcOpenMergeTool,
 		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(FILES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Files.Blame),
+			Handler:     gui.handleBlameFile,
+			Description: gui.Tr.LcBlameFile,
+		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(FILES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Files.Absorb),
+			Handler:     gui.handleAbsorb,
+			Mutating:    true,
+			Description: gui.Tr.LcAbsorb,
+		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(FILES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Files.ViewSafetySnapshots),
+			Handler:     gui.handleViewSafetySnapshots,
+			Mutating:    true,
+			Description: gui.Tr.LcViewSafetySnapshots,
+		},
 		{
 			ViewName:    "branches",
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.handleBranchPress,
+			Mutating:    true,
 			Description: gui.Tr.LcCheckout,
 		},
 		{
@@ -557,6 +612,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.CheckoutBranchByName),
 			Handler:     gui.handleCheckoutByName,
+			Mutating:    true,
 			Description: gui.Tr.LcCheckoutByName,
 		},
 		{
@@ -564,6 +620,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.ForceCheckoutBranch),
 			Handler:     gui.handleForceCheckout,
+			Mutating:    true,
 			Description: gui.Tr.LcForceCheckout,
 		},
 		{
@@ -571,6 +628,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.New),
 			Handler:     gui.handleNewBranchOffCurrentItem,
+			Mutating:    true,
 			Description: gui.Tr.LcNewBranch,
 		},
 		{
@@ -578,6 +636,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleDeleteBranch,
+			Mutating:    true,
 			Description: gui.Tr.LcDeleteBranch,
 		},
 		{
@@ -585,6 +644,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.RebaseBranch),
 			Handler:     gui.handleRebaseOntoLocalBranch,
+			Mutating:    true,
 			Description: gui.Tr.LcRebaseBranch,
 		},
 		{
@@ -592,6 +652,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.MergeIntoCurrentBranch),
 			Handler:     gui.handleMerge,
+			Mutating:    true,
 			Description: gui.Tr.LcMergeIntoCurrentBranch,
 		},
 		{
@@ -599,6 +660,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.ViewGitFlowOptions),
 			Handler:     gui.handleCreateGitFlowMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcGitFlowOptions,
 			OpensMenu:   true,
 		},
@@ -607,6 +669,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.FastForward),
 			Handler:     gui.handleFastForward,
+			Mutating:    true,
 			Description: gui.Tr.FastForward,
 		},
 		{
@@ -614,6 +677,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.ViewResetOptions),
 			Handler:     gui.handleCreateResetToBranchMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
@@ -622,6 +686,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.RenameBranch),
 			Handler:     gui.handleRenameBranch,
+			Mutating:    true,
 			Description: gui.Tr.LcRenameBranch,
 		},
 		{
@@ -643,6 +708,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(TAGS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.withSelectedTag(gui.handleCheckoutTag),
+			Mutating:    true,
 			Description: gui.Tr.LcCheckout,
 		},
 		{
@@ -650,6 +716,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(TAGS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.withSelectedTag(gui.handleDeleteTag),
+			Mutating:    true,
 			Description: gui.Tr.LcDeleteTag,
 		},
 		{
@@ -657,20 +724,31 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(TAGS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.PushTag),
 			Handler:     gui.withSelectedTag(gui.handlePushTag),
+			Mutating:    true,
 			Description: gui.Tr.LcPushTag,
 		},
+		{
+			ViewName:    "branches",
+			Contexts:    []string{string(TAGS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.OpenInBrowser),
+			Handler:     gui.withSelectedTag(gui.handleOpenTagInBrowser),
+			Description: gui.Tr.LcOpenTagInBrowser,
+		},
 		{
 			ViewName:    "branches",
 			Contexts:    []string{string(TAGS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.New),
 			Handler:     gui.handleCreateTag,
+			Mutating:    true,
 			Description: gui.Tr.LcCreateTag,
+			OpensMenu:   true,
 		},
 		{
 			ViewName:    "branches",
 			Contexts:    []string{string(TAGS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.ViewResetOptions),
 			Handler:     gui.withSelectedTag(gui.handleCreateResetToTagMenu),
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
@@ -681,6 +759,14 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleSwitchToSubCommits,
 			Description: gui.Tr.LcViewCommits,
 		},
+		{
+			ViewName:    "branches",
+			Contexts:    []string{string(LOCAL_BRANCHES_CONTEXT_KEY), string(REMOTE_BRANCHES_CONTEXT_KEY), string(TAGS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Branches.RebaseOnto),
+			Handler:     gui.handleRebaseOnto,
+			Mutating:    true,
+			Description: gui.Tr.LcRebaseOnto,
+		},
 		{
 			ViewName:    "branches",
 			Contexts:    []string{string(REMOTE_BRANCHES_CONTEXT_KEY)},
@@ -693,6 +779,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTE_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.ViewResetOptions),
 			Handler:     gui.handleCreateResetToRemoteBranchMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
@@ -708,6 +795,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.FetchRemote),
 			Handler:     gui.handleFetchRemote,
+			Mutating:    true,
 			Description: gui.Tr.LcFetchRemote,
 		},
 		{
@@ -715,6 +803,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.SquashDown),
 			Handler:     gui.handleCommitSquashDown,
+			Mutating:    true,
 			Description: gui.Tr.LcSquashDown,
 		},
 		{
@@ -722,6 +811,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.RenameCommit),
 			Handler:     gui.handleRenameCommit,
+			Mutating:    true,
 			Description: gui.Tr.LcRenameCommit,
 		},
 		{
@@ -729,6 +819,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.RenameCommitWithEditor),
 			Handler:     gui.handleRenameCommitEditor,
+			Mutating:    true,
 			Description: gui.Tr.LcRenameCommitEditor,
 		},
 		{
@@ -736,6 +827,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.ViewResetOptions),
 			Handler:     gui.handleCreateCommitResetMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcResetToThisCommit,
 		},
 		{
@@ -743,6 +835,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.MarkCommitAsFixup),
 			Handler:     gui.handleCommitFixup,
+			Mutating:    true,
 			Description: gui.Tr.LcFixupCommit,
 		},
 		{
@@ -750,6 +843,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.CreateFixupCommit),
 			Handler:     gui.handleCreateFixupCommit,
+			Mutating:    true,
 			Description: gui.Tr.LcCreateFixupCommit,
 		},
 		{
@@ -757,6 +851,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.SquashAboveCommits),
 			Handler:     gui.handleSquashAllAboveFixupCommits,
+			Mutating:    true,
 			Description: gui.Tr.LcSquashAboveCommits,
 		},
 		{
@@ -764,6 +859,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleCommitDelete,
+			Mutating:    true,
 			Description: gui.Tr.LcDeleteCommit,
 		},
 		{
@@ -771,6 +867,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.MoveDownCommit),
 			Handler:     gui.handleCommitMoveDown,
+			Mutating:    true,
 			Description: gui.Tr.LcMoveDownCommit,
 		},
 		{
@@ -778,6 +875,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.MoveUpCommit),
 			Handler:     gui.handleCommitMoveUp,
+			Mutating:    true,
 			Description: gui.Tr.LcMoveUpCommit,
 		},
 		{
@@ -785,6 +883,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Edit),
 			Handler:     gui.handleCommitEdit,
+			Mutating:    true,
 			Description: gui.Tr.LcEditCommit,
 		},
 		{
@@ -792,6 +891,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.AmendToCommit),
 			Handler:     gui.handleCommitAmendTo,
+			Mutating:    true,
 			Description: gui.Tr.LcAmendToCommit,
 		},
 		{
@@ -799,6 +899,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.PickCommit),
 			Handler:     gui.handleCommitPick,
+			Mutating:    true,
 			Description: gui.Tr.LcPickCommit,
 		},
 		{
@@ -806,6 +907,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.RevertCommit),
 			Handler:     gui.handleCommitRevert,
+			Mutating:    true,
 			Description: gui.Tr.LcRevertCommit,
 		},
 		{
@@ -822,6 +924,13 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleCopySelectedSideContextItemToClipboard,
 			Description: gui.Tr.LcCopyCommitShaToClipboard,
 		},
+		{
+			ViewName:    "commits",
+			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.OpenInBrowser),
+			Handler:     gui.handleOpenCommitInBrowser,
+			Description: gui.Tr.LcOpenCommitInBrowser,
+		},
 		{
 			ViewName:    "commits",
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
@@ -834,6 +943,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.PasteCommits),
 			Handler:     gui.HandlePasteCommits,
+			Mutating:    true,
 			Description: gui.Tr.LcPasteCommits,
 		},
 		{
@@ -848,6 +958,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.CheckoutCommit),
 			Handler:     gui.handleCheckoutCommit,
+			Mutating:    true,
 			Description: gui.Tr.LcCheckoutCommit,
 		},
 		{
@@ -856,6 +967,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Key:         gui.getKey(config.Universal.New),
 			Modifier:    gocui.ModNone,
 			Handler:     gui.handleNewBranchOffCurrentItem,
+			Mutating:    true,
 			Description: gui.Tr.LcCreateNewBranchFromCommit,
 		},
 		{
@@ -863,7 +975,9 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.TagCommit),
 			Handler:     gui.handleTagCommit,
+			Mutating:    true,
 			Description: gui.Tr.LcTagCommit,
+			OpensMenu:   true,
 		},
 		{
 			ViewName:    "commits",
@@ -879,6 +993,47 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleCopySelectedCommitMessageToClipboard,
 			Description: gui.Tr.LcCopyCommitMessageToClipboard,
 		},
+		{
+			ViewName:    "commits",
+			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Commits.ViewBisectOptions),
+			Handler:     gui.handleOpenBisectMenu,
+			Mutating:    true,
+			Description: gui.Tr.LcViewBisectOptions,
+			OpensMenu:   true,
+		},
+		{
+			ViewName:    "commits",
+			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Commits.SplitCommit),
+			Handler:     gui.handleSplitCommit,
+			Mutating:    true,
+			Description: gui.Tr.LcSplitCommit,
+		},
+		{
+			ViewName:    "commits",
+			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Commits.InsertExecTodo),
+			Handler:     gui.handleInsertExecTodo,
+			Mutating:    true,
+			Description: gui.Tr.LcInsertExecTodo,
+		},
+		{
+			ViewName:    "commits",
+			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Commits.InsertBreakTodo),
+			Handler:     gui.handleInsertBreakTodo,
+			Mutating:    true,
+			Description: gui.Tr.LcInsertBreakTodo,
+		},
+		{
+			ViewName:    "commits",
+			Contexts:    []string{string(BRANCH_COMMITS_CONTEXT_KEY), string(REFLOG_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Commits.RebaseOnto),
+			Handler:     gui.handleRebaseOnto,
+			Mutating:    true,
+			Description: gui.Tr.LcRebaseOnto,
+		},
 		{
 			ViewName:    "commits",
 			Contexts:    []string{string(REFLOG_COMMITS_CONTEXT_KEY)},
@@ -891,6 +1046,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REFLOG_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.handleCheckoutReflogCommit,
+			Mutating:    true,
 			Description: gui.Tr.LcCheckoutCommit,
 		},
 		{
@@ -898,6 +1054,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REFLOG_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.ViewResetOptions),
 			Handler:     gui.handleCreateReflogResetMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
@@ -929,6 +1086,13 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleCopySelectedSideContextItemToClipboard,
 			Description: gui.Tr.LcCopyCommitShaToClipboard,
 		},
+		{
+			ViewName:    "commits",
+			Contexts:    []string{string(REFLOG_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.OpenInBrowser),
+			Handler:     gui.handleOpenCommitInBrowser,
+			Description: gui.Tr.LcOpenCommitInBrowser,
+		},
 		{
 			ViewName:    "branches",
 			Contexts:    []string{string(SUB_COMMITS_CONTEXT_KEY)},
@@ -941,6 +1105,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUB_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.handleCheckoutSubCommit,
+			Mutating:    true,
 			Description: gui.Tr.LcCheckoutCommit,
 		},
 		{
@@ -948,6 +1113,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUB_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Commits.ViewResetOptions),
 			Handler:     gui.handleCreateSubCommitResetMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
@@ -956,6 +1122,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUB_COMMITS_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.New),
 			Handler:     gui.handleNewBranchOffCurrentItem,
+			Mutating:    true,
 			Description: gui.Tr.LcNewBranch,
 		},
 		{
@@ -986,6 +1153,13 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleCopySelectedSideContextItemToClipboard,
 			Description: gui.Tr.LcCopyCommitShaToClipboard,
 		},
+		{
+			ViewName:    "branches",
+			Contexts:    []string{string(SUB_COMMITS_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.OpenInBrowser),
+			Handler:     gui.handleOpenCommitInBrowser,
+			Description: gui.Tr.LcOpenCommitInBrowser,
+		},
 		{
 			ViewName:    "stash",
 			Key:         gui.getKey(config.Universal.GoInto),
@@ -996,24 +1170,28 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "stash",
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.handleStashApply,
+			Mutating:    true,
 			Description: gui.Tr.LcApply,
 		},
 		{
 			ViewName:    "stash",
 			Key:         gui.getKey(config.Stash.PopStash),
 			Handler:     gui.handleStashPop,
+			Mutating:    true,
 			Description: gui.Tr.LcPop,
 		},
 		{
 			ViewName:    "stash",
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleStashDrop,
+			Mutating:    true,
 			Description: gui.Tr.LcDrop,
 		},
 		{
 			ViewName:    "stash",
 			Key:         gui.getKey(config.Universal.New),
 			Handler:     gui.handleNewBranchOffCurrentItem,
+			Mutating:    true,
 			Description: gui.Tr.LcNewBranch,
 		},
 		{
@@ -1021,6 +1199,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Key:      gui.getKey(config.Universal.SubmitEditorText),
 			Modifier: gocui.ModNone,
 			Handler:  gui.handleCommitConfirm,
+			Mutating: true,
 		},
 		{
 			ViewName: "commitMessage",
@@ -1058,16 +1237,30 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleCopySelectedSideContextItemToClipboard,
 			Description: gui.Tr.LcCopyCommitFileNameToClipboard,
 		},
+		{
+			ViewName:    "commitFiles",
+			Key:         gui.getKey(config.Universal.OpenInBrowser),
+			Handler:     gui.handleOpenCommitFileInBrowser,
+			Description: gui.Tr.LcOpenFileInBrowser,
+		},
 		{
 			ViewName:    "commitFiles",
 			Key:         gui.getKey(config.CommitFiles.CheckoutCommitFile),
 			Handler:     gui.handleCheckoutCommitFile,
+			Mutating:    true,
 			Description: gui.Tr.LcCheckoutCommitFile,
 		},
+		{
+			ViewName:    "commitFiles",
+			Key:         gui.getKey(config.CommitFiles.Blame),
+			Handler:     gui.handleBlameCommitFile,
+			Description: gui.Tr.LcBlameFile,
+		},
 		{
 			ViewName:    "commitFiles",
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleDiscardOldFileChange,
+			Mutating:    true,
 			Description: gui.Tr.LcDiscardOldFileChange,
 		},
 		{
@@ -1080,6 +1273,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "commitFiles",
 			Key:         gui.getKey(config.Universal.Edit),
 			Handler:     gui.handleEditCommitFile,
+			Mutating:    true,
 			Description: gui.Tr.LcEditFile,
 		},
 		{
@@ -1189,6 +1383,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_STAGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.handleToggleStagedSelection,
+			Mutating:    true,
 			Description: gui.Tr.StageSelection,
 		},
 		{
@@ -1196,6 +1391,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_STAGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleResetSelection,
+			Mutating:    true,
 			Description: gui.Tr.ResetSelection,
 		},
 		{
@@ -1219,6 +1415,13 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Handler:     gui.handleOpenFileAtLine,
 			Description: gui.Tr.LcOpenFile,
 		},
+		{
+			ViewName:    "main",
+			Contexts:    []string{string(MAIN_PATCH_BUILDING_CONTEXT_KEY), string(MAIN_STAGING_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.OpenInBrowser),
+			Handler:     gui.handleOpenSelectedLinesInBrowser,
+			Description: gui.Tr.LcOpenLinesInBrowser,
+		},
 		{
 			ViewName:    "main",
 			Contexts:    []string{string(MAIN_PATCH_BUILDING_CONTEXT_KEY), string(MAIN_STAGING_CONTEXT_KEY)},
@@ -1294,6 +1497,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_STAGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Edit),
 			Handler:     gui.handleFileEdit,
+			Mutating:    true,
 			Description: gui.Tr.LcEditFile,
 		},
 		{
@@ -1409,6 +1613,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_STAGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.CommitChanges),
 			Handler:     gui.handleCommitPress,
+			Mutating:    true,
 			Description: gui.Tr.CommitChanges,
 		},
 		{
@@ -1416,6 +1621,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_STAGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.CommitChangesWithoutHook),
 			Handler:     gui.handleWIPCommitPress,
+			Mutating:    true,
 			Description: gui.Tr.LcCommitChangesWithoutHook,
 		},
 		{
@@ -1423,6 +1629,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_STAGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.CommitChangesWithEditor),
 			Handler:     gui.handleCommitEditorPress,
+			Mutating:    true,
 			Description: gui.Tr.CommitChangesWithEditor,
 		},
 		{
@@ -1437,6 +1644,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Files.OpenMergeTool),
 			Handler:     gui.handleOpenMergeTool,
+			Mutating:    true,
 			Description: gui.Tr.LcOpenMergeTool,
 		},
 		{
@@ -1444,6 +1652,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Select),
 			Handler:     gui.handlePickHunk,
+			Mutating:    true,
 			Description: gui.Tr.PickHunk,
 		},
 		{
@@ -1451,6 +1660,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Main.PickBothHunks),
 			Handler:     gui.handlePickBothHunks,
+			Mutating:    true,
 			Description: gui.Tr.PickBothHunks,
 		},
 		{
@@ -1471,29 +1681,29 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			ViewName:    "main",
 			Contexts:    []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.PrevItem),
-			Handler:     gui.handleSelectTop,
-			Description: gui.Tr.SelectTop,
+			Handler:     gui.handleSelectPrevConflictHunk,
+			Description: gui.Tr.SelectPrevHunk,
 		},
 		{
 			ViewName:    "main",
 			Contexts:    []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.NextItem),
-			Handler:     gui.handleSelectBottom,
-			Description: gui.Tr.SelectBottom,
+			Handler:     gui.handleSelectNextConflictHunk,
+			Description: gui.Tr.SelectNextHunk,
 		},
 		{
 			ViewName: "main",
 			Contexts: []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:      gocui.MouseWheelUp,
 			Modifier: gocui.ModNone,
-			Handler:  gui.handleSelectTop,
+			Handler:  gui.handleSelectPrevConflictHunk,
 		},
 		{
 			ViewName: "main",
 			Contexts: []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:      gocui.MouseWheelDown,
 			Modifier: gocui.ModNone,
-			Handler:  gui.handleSelectBottom,
+			Handler:  gui.handleSelectNextConflictHunk,
 		},
 		{
 			ViewName: "main",
@@ -1514,22 +1724,107 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts: []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:      gui.getKey(config.Universal.PrevItemAlt),
 			Modifier: gocui.ModNone,
-			Handler:  gui.handleSelectTop,
+			Handler:  gui.handleSelectPrevConflictHunk,
 		},
 		{
 			ViewName: "main",
 			Contexts: []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:      gui.getKey(config.Universal.NextItemAlt),
 			Modifier: gocui.ModNone,
-			Handler:  gui.handleSelectBottom,
+			Handler:  gui.handleSelectNextConflictHunk,
 		},
 		{
 			ViewName:    "main",
 			Contexts:    []string{string(MAIN_MERGING_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Undo),
 			Handler:     gui.handlePopFileSnapshot,
+			Mutating:    true,
 			Description: gui.Tr.LcUndo,
 		},
+		{
+			ViewName:    "main",
+			Contexts:    []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.Return),
+			Handler:     gui.handleEscapeBlame,
+			Description: gui.Tr.LcExitBlame,
+		},
+		{
+			ViewName:    "main",
+			Contexts:    []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.GoInto),
+			Handler:     gui.handleBlameGoToCommit,
+			Description: gui.Tr.LcBlameGoToCommit,
+		},
+		{
+			ViewName:    "main",
+			Contexts:    []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.PrevBlock),
+			Handler:     gui.handleBlameParent,
+			Description: gui.Tr.LcBlameParent,
+		},
+		{
+			ViewName:    "main",
+			Contexts:    []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.NextBlock),
+			Handler:     gui.handleBlameStepForward,
+			Description: gui.Tr.LcBlameStepForward,
+		},
+		{
+			ViewName: "main",
+			Contexts: []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:      gui.getKey(config.Universal.PrevBlockAlt),
+			Modifier: gocui.ModNone,
+			Handler:  gui.handleBlameParent,
+		},
+		{
+			ViewName: "main",
+			Contexts: []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:      gui.getKey(config.Universal.NextBlockAlt),
+			Modifier: gocui.ModNone,
+			Handler:  gui.handleBlameStepForward,
+		},
+		{
+			ViewName:    "main",
+			Contexts:    []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.PrevItem),
+			Handler:     gui.handleBlameSelectPrevLine,
+			Description: gui.Tr.PrevLine,
+		},
+		{
+			ViewName:    "main",
+			Contexts:    []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.NextItem),
+			Handler:     gui.handleBlameSelectNextLine,
+			Description: gui.Tr.NextLine,
+		},
+		{
+			ViewName: "main",
+			Contexts: []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:      gui.getKey(config.Universal.PrevItemAlt),
+			Modifier: gocui.ModNone,
+			Handler:  gui.handleBlameSelectPrevLine,
+		},
+		{
+			ViewName: "main",
+			Contexts: []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:      gui.getKey(config.Universal.NextItemAlt),
+			Modifier: gocui.ModNone,
+			Handler:  gui.handleBlameSelectNextLine,
+		},
+		{
+			ViewName: "main",
+			Contexts: []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:      gocui.MouseWheelUp,
+			Modifier: gocui.ModNone,
+			Handler:  gui.handleBlameSelectPrevLine,
+		},
+		{
+			ViewName: "main",
+			Contexts: []string{string(MAIN_BLAME_CONTEXT_KEY)},
+			Key:      gocui.MouseWheelDown,
+			Modifier: gocui.ModNone,
+			Handler:  gui.handleBlameSelectNextLine,
+		},
 		{
 			ViewName: "branches",
 			Contexts: []string{string(REMOTES_CONTEXT_KEY)},
@@ -1542,6 +1837,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.New),
 			Handler:     gui.handleAddRemote,
+			Mutating:    true,
 			Description: gui.Tr.LcAddNewRemote,
 		},
 		{
@@ -1549,6 +1845,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleRemoveRemote,
+			Mutating:    true,
 			Description: gui.Tr.LcRemoveRemote,
 		},
 		{
@@ -1556,6 +1853,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Edit),
 			Handler:     gui.handleEditRemote,
+			Mutating:    true,
 			Description: gui.Tr.LcEditRemote,
 		},
 		{
@@ -1564,6 +1862,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Key:      gui.getKey(config.Universal.Select),
 			// gonna use the exact same handler as the 'n' keybinding because everybody wants this to happen when they checkout a remote branch
 			Handler:     gui.handleNewBranchOffCurrentItem,
+			Mutating:    true,
 			Description: gui.Tr.LcCheckout,
 		},
 		{
@@ -1571,6 +1870,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTE_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.New),
 			Handler:     gui.handleNewBranchOffCurrentItem,
+			Mutating:    true,
 			Description: gui.Tr.LcNewBranch,
 		},
 		{
@@ -1578,6 +1878,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTE_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.MergeIntoCurrentBranch),
 			Handler:     gui.handleMergeRemoteBranch,
+			Mutating:    true,
 			Description: gui.Tr.LcMergeIntoCurrentBranch,
 		},
 		{
@@ -1585,6 +1886,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTE_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.handleDeleteRemoteBranch,
+			Mutating:    true,
 			Description: gui.Tr.LcDeleteBranch,
 		},
 		{
@@ -1592,6 +1894,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTE_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.RebaseBranch),
 			Handler:     gui.handleRebaseOntoRemoteBranch,
+			Mutating:    true,
 			Description: gui.Tr.LcRebaseBranch,
 		},
 		{
@@ -1599,6 +1902,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(REMOTE_BRANCHES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Branches.SetUpstream),
 			Handler:     gui.handleSetBranchUpstream,
+			Mutating:    true,
 			Description: gui.Tr.LcSetUpstream,
 		},
 		{
@@ -1680,6 +1984,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUBMODULES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Remove),
 			Handler:     gui.forSubmodule(gui.handleResetRemoveSubmodule),
+			Mutating:    true,
 			Description: gui.Tr.LcViewResetAndRemoveOptions,
 			OpensMenu:   true,
 		},
@@ -1688,6 +1993,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUBMODULES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Submodules.Update),
 			Handler:     gui.forSubmodule(gui.handleUpdateSubmodule),
+			Mutating:    true,
 			Description: gui.Tr.LcSubmoduleUpdate,
 		},
 		{
@@ -1695,6 +2001,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUBMODULES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.New),
 			Handler:     gui.handleAddSubmodule,
+			Mutating:    true,
 			Description: gui.Tr.LcAddSubmodule,
 		},
 		{
@@ -1702,6 +2009,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUBMODULES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Universal.Edit),
 			Handler:     gui.forSubmodule(gui.handleEditSubmoduleUrl),
+			Mutating:    true,
 			Description: gui.Tr.LcEditSubmoduleUrl,
 		},
 		{
@@ -1709,6 +2017,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUBMODULES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Submodules.Init),
 			Handler:     gui.forSubmodule(gui.handleSubmoduleInit),
+			Mutating:    true,
 			Description: gui.Tr.LcInitSubmodule,
 		},
 		{
@@ -1716,9 +2025,48 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 			Contexts:    []string{string(SUBMODULES_CONTEXT_KEY)},
 			Key:         gui.getKey(config.Submodules.BulkMenu),
 			Handler:     gui.handleBulkSubmoduleActionsMenu,
+			Mutating:    true,
 			Description: gui.Tr.LcViewBulkSubmoduleOptions,
 			OpensMenu:   true,
 		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(WORKTREES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.CopyToClipboard),
+			Handler:     gui.handleCopySelectedSideContextItemToClipboard,
+			Description: gui.Tr.LcCopyWorktreePathToClipboard,
+		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(WORKTREES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.Select),
+			Handler:     gui.forWorktree(gui.handleWorktreeEnter),
+			Description: gui.Tr.LcSwitchToWorktree,
+		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(WORKTREES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.GoInto),
+			Handler:     gui.forWorktree(gui.handleWorktreeEnter),
+			Description: gui.Tr.LcSwitchToWorktree,
+		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(WORKTREES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.New),
+			Handler:     gui.handleAddWorktree,
+			Mutating:    true,
+			Description: gui.Tr.LcAddWorktree,
+		},
+		{
+			ViewName:    "files",
+			Contexts:    []string{string(WORKTREES_CONTEXT_KEY)},
+			Key:         gui.getKey(config.Universal.Remove),
+			Handler:     gui.forWorktree(gui.handleRemoveWorktreeMenu),
+			Mutating:    true,
+			Description: gui.Tr.LcViewWorktreeOptions,
+			OpensMenu:   true,
+		},
 		{
 			ViewName:    "files",
 			Contexts:    []string{string(FILES_CONTEXT_KEY)},
@@ -1821,7 +2169,7 @@ func (gui *Gui) GetInitialKeybindings() []*Binding {
 
 	bindings = append(bindings, gui.getListContextKeyBindings()...)
 
-	return bindings
+	return gui.guardMutatingBindings(bindings)
 }
 
 func (gui *Gui) keybindings() error {
@@ -171,6 +171,55 @@ func (s *State) CurrentLineNumber() int {
 	return s.CurrentHunk().LineNumberOfLine(s.selectedLineIdx)
 }
 
+// SelectedLineNumberRange returns the first and last line numbers spanned by
+// the selection, in either the old or the new version of the file. Selected
+// lines at either end that aren't in that version, e.g. added lines when we
+// want the old version, are left out. If that leaves nothing, ok is false.
+func (s *State) SelectedLineNumberRange(oldVersion bool) (int, int, bool) {
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+
+	for firstLineIdx <= lastLineIdx && !s.isLineInVersion(firstLineIdx, oldVersion) {
+		firstLineIdx++
+	}
+	for lastLineIdx >= firstLineIdx && !s.isLineInVersion(lastLineIdx, oldVersion) {
+		lastLineIdx--
+	}
+	if firstLineIdx > lastLineIdx {
+		return 0, 0, false
+	}
+
+	return s.lineNumberOfLine(firstLineIdx, oldVersion), s.lineNumberOfLine(lastLineIdx, oldVersion), true
+}
+
+// isLineInVersion tells us whether the line at the index is a line of the old
+// or the new version of the file, as opposed to e.g. a hunk header
+func (s *State) isLineInVersion(idx int, oldVersion bool) bool {
+	if idx < 0 || idx >= len(s.patchParser.PatchLines) {
+		return false
+	}
+
+	switch s.patchParser.PatchLines[idx].Kind {
+	case patch.CONTEXT:
+		return true
+	case patch.DELETION:
+		return oldVersion
+	case patch.ADDITION:
+		return !oldVersion
+	default:
+		return false
+	}
+}
+
+func (s *State) lineNumberOfLine(idx int, oldVersion bool) int {
+	hunk := s.patchParser.GetHunkContainingLine(idx, 0)
+
+	if oldVersion {
+		return hunk.OldLineNumberOfLine(idx)
+	}
+
+	return hunk.LineNumberOfLine(idx)
+}
+
 func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
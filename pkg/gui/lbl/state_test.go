@@ -0,0 +1,127 @@
+package lbl
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectedLineNumberRange(t *testing.T) {
+	diff := `diff --git a/fruit.txt b/fruit.txt
+index 1234567..89abcde 100644
+--- a/fruit.txt
++++ b/fruit.txt
+@@ -2,3 +2,4 @@
+ two
+-three
++THREE
++extra
+ four
+`
+
+	newFileDiff := `diff --git a/new.txt b/new.txt
+new file mode 100644
+index 0000000..1234567
+--- /dev/null
++++ b/new.txt
+@@ -0,0 +1,2 @@
++apple
++orange
+`
+
+	type scenario struct {
+		name              string
+		diff              string
+		firstLineIdx      int
+		lastLineIdx       int
+		oldVersion        bool
+		expectedFirstLine int
+		expectedLastLine  int
+		expectedOk        bool
+	}
+
+	scenarios := []scenario{
+		{
+			name:              "range ending on an added line",
+			diff:              diff,
+			firstLineIdx:      5,
+			lastLineIdx:       8,
+			oldVersion:        true,
+			expectedFirstLine: 2,
+			expectedLastLine:  3,
+			expectedOk:        true,
+		},
+		{
+			name:              "range starting on an added line",
+			diff:              diff,
+			firstLineIdx:      7,
+			lastLineIdx:       9,
+			oldVersion:        true,
+			expectedFirstLine: 4,
+			expectedLastLine:  4,
+			expectedOk:        true,
+		},
+		{
+			name:              "range starting on the hunk header",
+			diff:              diff,
+			firstLineIdx:      4,
+			lastLineIdx:       6,
+			oldVersion:        true,
+			expectedFirstLine: 2,
+			expectedLastLine:  3,
+			expectedOk:        true,
+		},
+		{
+			name:         "only added lines",
+			diff:         diff,
+			firstLineIdx: 7,
+			lastLineIdx:  8,
+			oldVersion:   true,
+			expectedOk:   false,
+		},
+		{
+			name:              "range ending on a removed line in the new version",
+			diff:              diff,
+			firstLineIdx:      5,
+			lastLineIdx:       6,
+			oldVersion:        false,
+			expectedFirstLine: 2,
+			expectedLastLine:  2,
+			expectedOk:        true,
+		},
+		{
+			name:         "new file",
+			diff:         newFileDiff,
+			firstLineIdx: 6,
+			lastLineIdx:  7,
+			oldVersion:   true,
+			expectedOk:   false,
+		},
+		{
+			name:              "new file in the new version",
+			diff:              newFileDiff,
+			firstLineIdx:      6,
+			lastLineIdx:       7,
+			oldVersion:        false,
+			expectedFirstLine: 1,
+			expectedLastLine:  2,
+			expectedOk:        true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			state := NewState(s.diff, s.firstLineIdx, nil, utils.NewDummyLog())
+			state.selectedLineIdx = s.lastLineIdx
+
+			firstLine, lastLine, ok := state.SelectedLineNumberRange(s.oldVersion)
+			assert.Equal(t, s.expectedOk, ok)
+			if s.expectedOk {
+				assert.Equal(t, s.expectedFirstLine, firstLine)
+				assert.Equal(t, s.expectedLastLine, lastLine)
+			}
+		})
+	}
+}
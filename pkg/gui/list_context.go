@@ -14,12 +14,20 @@ type ListContext struct {
 	Gui                        *Gui
 	ResetMainViewOriginOnFocus bool
 
+	// whether the user can select a range of items at once, so that actions can
+	// apply to each of them
+	SupportsRangeSelect bool
+
 	*BasicContext
 }
 
 type IListPanelState interface {
 	SetSelectedLineIdx(int)
 	GetSelectedLineIdx() int
+	StartRangeSelect()
+	CancelRangeSelect()
+	IsRangeSelect() bool
+	GetSelectedLineRange() (int, int)
 }
 
 type ListItem interface {
@@ -52,8 +60,9 @@ func (lc *ListContext) OnRender() error {
 	}
 
 	if lc.GetDisplayStrings != nil {
-		lc.Gui.refreshSelectedLine(lc.GetPanelState(), lc.GetItemsLength())
-		lc.Gui.renderDisplayStrings(view, lc.GetDisplayStrings())
+		panelState := lc.GetPanelState()
+		lc.Gui.refreshSelectedLine(panelState, lc.GetItemsLength())
+		lc.Gui.renderDisplayStrings(view, lc.GetDisplayStrings(), panelState)
 	}
 
 	return nil
@@ -111,6 +120,8 @@ func (lc *ListContext) handleNextLine() error {
 	return lc.handleLineChange(1)
 }
 
+// handleLineChange moves the selected line. If we're range selecting, the other
+// end of the range stays where it is
 func (lc *ListContext) handleLineChange(change int) error {
 	if !lc.Gui.isPopupPanel(lc.ViewName) && lc.Gui.popupPanelFocused() {
 		return nil
@@ -129,9 +140,43 @@ func (lc *ListContext) handleLineChange(change int) error {
 	lc.Gui.changeSelectedLine(lc.GetPanelState(), lc.GetItemsLength(), change)
 	view.FocusPoint(0, lc.GetPanelState().GetSelectedLineIdx())
 
+	if lc.GetPanelState().IsRangeSelect() {
+		if err := lc.HandleRender(); err != nil {
+			return err
+		}
+	}
+
 	return lc.HandleFocus()
 }
 
+func (lc *ListContext) handleToggleRangeSelect() error {
+	if !lc.Gui.isPopupPanel(lc.ViewName) && lc.Gui.popupPanelFocused() {
+		return nil
+	}
+
+	panelState := lc.GetPanelState()
+	if panelState.IsRangeSelect() {
+		panelState.CancelRangeSelect()
+	} else {
+		panelState.StartRangeSelect()
+	}
+
+	return lc.HandleRender()
+}
+
+// cancelRangeSelect goes back to selecting a single item, re-rendering if there
+// was a range highlighted
+func (lc *ListContext) cancelRangeSelect() error {
+	panelState := lc.GetPanelState()
+	if !panelState.IsRangeSelect() {
+		return nil
+	}
+
+	panelState.CancelRangeSelect()
+
+	return lc.HandleRender()
+}
+
 func (lc *ListContext) handleNextPage() error {
 	view, err := lc.Gui.g.View(lc.ViewName)
 	if err != nil {
@@ -183,6 +228,10 @@ func (lc *ListContext) handleClick() error {
 		return nil
 	}
 
+	if err := lc.cancelRangeSelect(); err != nil {
+		return err
+	}
+
 	lc.GetPanelState().SetSelectedLineIdx(newSelectedLineIdx)
 
 	prevViewName := lc.Gui.currentViewName()
@@ -192,7 +241,40 @@ func (lc *ListContext) handleClick() error {
 	return lc.HandleFocus()
 }
 
+// handleDrag extends the selected range to wherever the mouse has been dragged
+// to. The drag starts with a click, which will have selected the line that the
+// range starts from.
+func (lc *ListContext) handleDrag() error {
+	if !lc.Gui.isPopupPanel(lc.ViewName) && lc.Gui.popupPanelFocused() {
+		return nil
+	}
+
+	view, err := lc.Gui.g.View(lc.ViewName)
+	if err != nil {
+		return nil
+	}
+
+	newSelectedLineIdx := view.SelectedLineIdx()
+	if newSelectedLineIdx < 0 || newSelectedLineIdx > lc.GetItemsLength()-1 {
+		return nil
+	}
+
+	panelState := lc.GetPanelState()
+	panelState.StartRangeSelect()
+	panelState.SetSelectedLineIdx(newSelectedLineIdx)
+
+	if err := lc.HandleRender(); err != nil {
+		return err
+	}
+
+	return lc.HandleFocus()
+}
+
 func (lc *ListContext) onSearchSelect(selectedLineIdx int) error {
+	if err := lc.cancelRangeSelect(); err != nil {
+		return err
+	}
+
 	lc.GetPanelState().SetSelectedLineIdx(selectedLineIdx)
 	return lc.HandleFocus()
 }
@@ -37,9 +37,10 @@ func (gui *Gui) filesListContext() *ListContext {
 		GetItemsLength:             func() int { return gui.State.FileManager.GetItemsLength() },
 		GetPanelState:              func() IListPanelState { return gui.State.Panels.Files },
 		OnFocus:                    gui.focusAndSelectFile,
-		OnClickSelectedItem:        gui.handleFilePress,
+		OnClickSelectedItem:        gui.guardMutating(gui.handleFilePress),
 		Gui:                        gui,
 		ResetMainViewOriginOnFocus: false,
+		SupportsRangeSelect:        true,
 		GetDisplayStrings: func() [][]string {
 			lines := gui.State.FileManager.Render(gui.State.Modes.Diffing.Ref, gui.State.Submodules)
 			mappedLines := make([][]string, len(lines))
@@ -164,6 +165,7 @@ func (gui *Gui) branchCommitsListContext() *ListContext {
 		OnClickSelectedItem:        gui.handleViewCommitFiles,
 		Gui:                        gui,
 		ResetMainViewOriginOnFocus: true,
+		SupportsRangeSelect:        true,
 		GetDisplayStrings: func() [][]string {
 			return presentation.GetCommitListDisplayStrings(
 				gui.State.Commits,
@@ -171,6 +173,8 @@ func (gui *Gui) branchCommitsListContext() *ListContext {
 				gui.cherryPickedCommitShaMap(),
 				gui.State.Modes.Diffing.Ref,
 				parseEmoji,
+				gui.State.Modes.Bisecting.GetInfo(),
+				gui.shouldShowGraph(),
 			)
 		},
 		SelectedItem: func() (ListItem, bool) {
@@ -231,6 +235,8 @@ func (gui *Gui) subCommitsListContext() *ListContext {
 				gui.cherryPickedCommitShaMap(),
 				gui.State.Modes.Diffing.Ref,
 				parseEmoji,
+				gui.State.Modes.Bisecting.GetInfo(),
+				gui.shouldShowGraph(),
 			)
 		},
 		SelectedItem: func() (ListItem, bool) {
@@ -319,6 +325,30 @@ func (gui *Gui) submodulesListContext() *ListContext {
 	}
 }
 
+func (gui *Gui) worktreesListContext() *ListContext {
+	return &ListContext{
+		BasicContext: &BasicContext{
+			ViewName:   "files",
+			WindowName: "files",
+			Key:        WORKTREES_CONTEXT_KEY,
+			Kind:       SIDE_CONTEXT,
+		},
+		GetItemsLength:             func() int { return len(gui.State.Worktrees) },
+		GetPanelState:              func() IListPanelState { return gui.State.Panels.Worktrees },
+		OnFocus:                    gui.handleWorktreeSelect,
+		OnClickSelectedItem:        gui.forWorktree(gui.handleWorktreeEnter),
+		Gui:                        gui,
+		ResetMainViewOriginOnFocus: true,
+		GetDisplayStrings: func() [][]string {
+			return presentation.GetWorktreeListDisplayStrings(gui.State.Worktrees)
+		},
+		SelectedItem: func() (ListItem, bool) {
+			item := gui.getSelectedWorktree()
+			return item, item != nil
+		},
+	}
+}
+
 func (gui *Gui) suggestionsListContext() *ListContext {
 	return &ListContext{
 		BasicContext: &BasicContext{
@@ -353,6 +383,7 @@ func (gui *Gui) getListContexts() []*ListContext {
 		gui.State.Contexts.Stash,
 		gui.State.Contexts.CommitFiles,
 		gui.State.Contexts.Submodules,
+		gui.State.Contexts.Worktrees,
 		gui.State.Contexts.Suggestions,
 	}
 }
@@ -378,6 +409,27 @@ func (gui *Gui) getListContextKeyBindings() []*Binding {
 			{ViewName: listContext.ViewName, Contexts: []string{string(listContext.Key)}, Key: gocui.MouseLeft, Modifier: gocui.ModNone, Handler: listContext.handleClick},
 		}...)
 
+		// range select is a toggle rather than shift+up/down because gocui strips
+		// the shift modifier from key events, so we'd only ever see a plain arrow
+		if listContext.SupportsRangeSelect {
+			bindings = append(bindings, []*Binding{
+				{
+					ViewName:    listContext.ViewName,
+					Contexts:    []string{string(listContext.Key)},
+					Key:         gui.getKey(keybindingConfig.Universal.ToggleRangeSelect),
+					Handler:     listContext.handleToggleRangeSelect,
+					Description: gui.Tr.LcToggleRangeSelect,
+				},
+				{
+					ViewName: listContext.ViewName,
+					Contexts: []string{string(listContext.Key)},
+					Key:      gocui.MouseLeft,
+					Modifier: gocui.ModMotion,
+					Handler:  listContext.handleDrag,
+				},
+			}...)
+		}
+
 		// the commits panel needs to lazyload things so it has a couple of its own handlers
 		openSearchHandler := gui.handleOpenSearch
 		gotoBottomHandler := listContext.handleGotoBottom
@@ -6,12 +6,14 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
-// LineType tells us whether a given line is a start/middle/end marker of a conflict,
-// or if it's not a marker at all
+// LineType tells us whether a given line is a start/ancestor/middle/end marker
+// of a conflict, or if it's not a marker at all
 type LineType int
 
 const (
 	START LineType = iota
+	// only present when using the diff3 or zdiff3 conflict style
+	ANCESTOR
 	MIDDLE
 	END
 	NOT_A_MARKER
@@ -28,10 +30,20 @@ func findConflicts(content string) []*mergeConflict {
 	for i, line := range utils.SplitLines(content) {
 		switch determineLineType(line) {
 		case START:
-			newConflict = &mergeConflict{start: i}
+			newConflict = &mergeConflict{start: i, ancestor: -1}
+		case ANCESTOR:
+			// markers outside of a conflict are just part of the file's content
+			if newConflict != nil {
+				newConflict.ancestor = i
+			}
 		case MIDDLE:
-			newConflict.middle = i
+			if newConflict != nil {
+				newConflict.middle = i
+			}
 		case END:
+			if newConflict == nil {
+				continue
+			}
 			newConflict.end = i
 			conflicts = append(conflicts, newConflict)
 			// reset value to avoid any possible silent mutations in further iterations
@@ -50,6 +62,8 @@ func determineLineType(line string) LineType {
 	switch {
 	case strings.HasPrefix(trimmedLine, "<<<<<<< "):
 		return START
+	case strings.HasPrefix(trimmedLine, "||||||| "), trimmedLine == "|||||||":
+		return ANCESTOR
 	case trimmedLine == "=======":
 		return MIDDLE
 	case strings.HasPrefix(trimmedLine, ">>>>>>> "):
@@ -41,6 +41,14 @@ func TestDetermineLineType(t *testing.T) {
 			line:     "<<<<<<< ours:my_branch",
 			expected: START,
 		},
+		{
+			line:     "||||||| merged common ancestors",
+			expected: ANCESTOR,
+		},
+		{
+			line:     "++||||||| base",
+			expected: ANCESTOR,
+		},
 		{
 			line:     "=======",
 			expected: MIDDLE,
@@ -16,11 +16,15 @@ func ColoredConflictFile(content string, state *State, hasFocus bool) string {
 	var outputBuffer bytes.Buffer
 	for i, line := range utils.SplitLines(content) {
 		colourAttr := theme.DefaultTextColor
-		if i == conflict.start || i == conflict.middle || i == conflict.end {
+		if i == conflict.start || i == conflict.ancestor || i == conflict.middle || i == conflict.end {
 			colourAttr = color.FgRed
+		} else if conflict.hasAncestor() && conflict.ancestor < i && i < conflict.middle {
+			// the common ancestor's hunk is only there for reference, so we want
+			// it to stand out from our hunk and their hunk
+			colourAttr = color.FgCyan
 		}
 		colour := color.New(colourAttr)
-		if hasFocus && state.conflictIndex < len(state.conflicts) && *state.conflicts[state.conflictIndex] == *conflict && shouldHighlightLine(i, conflict, state.conflictTop) {
+		if hasFocus && state.conflictIndex < len(state.conflicts) && *state.conflicts[state.conflictIndex] == *conflict && shouldHighlightLine(i, conflict, state.Selection()) {
 			colour.Add(color.Bold)
 			colour.Add(theme.SelectedRangeBgColor)
 		}
@@ -36,6 +40,15 @@ func shiftConflict(conflicts []*mergeConflict) (*mergeConflict, []*mergeConflict
 	return conflicts[0], conflicts[1:]
 }
 
-func shouldHighlightLine(index int, conflict *mergeConflict, top bool) bool {
-	return (index >= conflict.start && index <= conflict.middle && top) || (index >= conflict.middle && index <= conflict.end && !top)
+func shouldHighlightLine(index int, conflict *mergeConflict, selection Selection) bool {
+	switch selection {
+	case TOP:
+		return index >= conflict.start && index <= conflict.topEnd()
+	case BASE:
+		return index >= conflict.ancestor && index <= conflict.middle
+	case BOTTOM:
+		return index >= conflict.middle && index <= conflict.end
+	default:
+		return false
+	}
 }
@@ -11,53 +11,107 @@ type Selection int
 
 const (
 	TOP Selection = iota
+	// the common ancestor's version, which we only know about when using the
+	// diff3 or zdiff3 conflict style
+	BASE
 	BOTTOM
 	BOTH
 )
 
 // mergeConflict : A git conflict with a start middle and end corresponding to line
-// numbers in the file where the conflict markers appear
+// numbers in the file where the conflict markers appear. If the conflict
+// includes the common ancestor's version, ancestor is the line of its marker,
+// otherwise it's -1
 type mergeConflict struct {
-	start  int
-	middle int
-	end    int
+	start    int
+	ancestor int
+	middle   int
+	end      int
+}
+
+func (c *mergeConflict) hasAncestor() bool {
+	return c.ancestor >= 0
+}
+
+// topEnd returns the line of the marker that follows the top hunk
+func (c *mergeConflict) topEnd() int {
+	if c.hasAncestor() {
+		return c.ancestor
+	}
+
+	return c.middle
+}
+
+// availableSelections returns the hunks that can be picked for the conflict,
+// in the order they appear in the file
+func (c *mergeConflict) availableSelections() []Selection {
+	if c.hasAncestor() {
+		return []Selection{TOP, BASE, BOTTOM}
+	}
+
+	return []Selection{TOP, BOTTOM}
 }
 
 type State struct {
 	sync.Mutex
 	conflictIndex int
-	conflictTop   bool
-	conflicts     []*mergeConflict
-	EditHistory   *stack.Stack
+	// index into the current conflict's available selections
+	selectionIndex int
+	conflicts      []*mergeConflict
+	EditHistory    *stack.Stack
 }
 
 func NewState() *State {
 	return &State{
-		Mutex:         sync.Mutex{},
-		conflictIndex: 0,
-		conflictTop:   true,
-		conflicts:     []*mergeConflict{},
-		EditHistory:   stack.New(),
+		Mutex:          sync.Mutex{},
+		conflictIndex:  0,
+		selectionIndex: 0,
+		conflicts:      []*mergeConflict{},
+		EditHistory:    stack.New(),
 	}
 }
 
-func (s *State) SelectTopOption() {
-	s.conflictTop = true
+func (s *State) SelectPrevOption() {
+	if s.selectionIndex > 0 {
+		s.selectionIndex--
+	}
 }
 
-func (s *State) SelectBottomOption() {
-	s.conflictTop = false
+func (s *State) SelectNextOption() {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return
+	}
+
+	if s.selectionIndex < len(conflict.availableSelections())-1 {
+		s.selectionIndex++
+	}
 }
 
 func (s *State) SelectNextConflict() {
 	if s.conflictIndex < len(s.conflicts)-1 {
 		s.conflictIndex++
+		s.clampSelectionIndex()
 	}
 }
 
 func (s *State) SelectPrevConflict() {
 	if s.conflictIndex > 0 {
 		s.conflictIndex--
+		s.clampSelectionIndex()
+	}
+}
+
+// clampSelectionIndex ensures that we haven't selected the ancestor's hunk
+// after moving to a conflict that doesn't have one
+func (s *State) clampSelectionIndex() {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return
+	}
+
+	if lastIdx := len(conflict.availableSelections()) - 1; s.selectionIndex > lastIdx {
+		s.selectionIndex = lastIdx
 	}
 }
 
@@ -93,6 +147,8 @@ func (s *State) setConflicts(conflicts []*mergeConflict) {
 	} else if s.conflictIndex < 0 {
 		s.conflictIndex = 0
 	}
+
+	s.clampSelectionIndex()
 }
 
 func (s *State) NoConflicts() bool {
@@ -100,11 +156,12 @@ func (s *State) NoConflicts() bool {
 }
 
 func (s *State) Selection() Selection {
-	if s.conflictTop {
+	conflict := s.currentConflict()
+	if conflict == nil {
 		return TOP
-	} else {
-		return BOTTOM
 	}
+
+	return conflict.availableSelections()[s.selectionIndex]
 }
 
 func (s *State) IsFinalConflict() bool {
@@ -140,14 +197,34 @@ func (s *State) ContentAfterConflictResolve(path string, selection Selection) (b
 }
 
 func isIndexToDelete(i int, conflict *mergeConflict, selection Selection) bool {
+	if i < conflict.start || conflict.end < i {
+		return false
+	}
+
 	isMarkerLine :=
 		i == conflict.middle ||
 			i == conflict.start ||
+			i == conflict.ancestor ||
 			i == conflict.end
 
-	isUnwantedContent :=
-		(selection == BOTTOM && conflict.start < i && i < conflict.middle) ||
-			(selection == TOP && conflict.middle < i && i < conflict.end)
+	if isMarkerLine {
+		return true
+	}
 
-	return isMarkerLine || isUnwantedContent
+	// BOTH means both our hunk and their hunk, without the ancestor's
+	return selectionOfLine(i, conflict) != selection &&
+		!(selection == BOTH && selectionOfLine(i, conflict) != BASE)
+}
+
+// selectionOfLine tells us which hunk of the conflict the given line belongs
+// to. The line is expected to be within the conflict and not a marker line
+func selectionOfLine(i int, conflict *mergeConflict) Selection {
+	switch {
+	case i < conflict.topEnd():
+		return TOP
+	case i < conflict.middle:
+		return BASE
+	default:
+		return BOTTOM
+	}
 }
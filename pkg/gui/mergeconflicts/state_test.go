@@ -1,6 +1,7 @@
 package mergeconflicts
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,34 +62,95 @@ baz
 `,
 			expected: []*mergeConflict{
 				{
-					start:  0,
-					middle: 2,
-					end:    4,
+					start:    0,
+					ancestor: -1,
+					middle:   2,
+					end:      4,
 				},
 				{
-					start:  6,
-					middle: 9,
-					end:    11,
+					start:    6,
+					ancestor: -1,
+					middle:   9,
+					end:      11,
 				},
 				{
-					start:  13,
-					middle: 15,
-					end:    17,
+					start:    13,
+					ancestor: -1,
+					middle:   15,
+					end:      17,
 				},
 				{
-					start:  19,
-					middle: 21,
-					end:    23,
+					start:    19,
+					ancestor: -1,
+					middle:   21,
+					end:      23,
 				},
 				{
-					start:  25,
-					middle: 27,
-					end:    29,
+					start:    25,
+					ancestor: -1,
+					middle:   27,
+					end:      29,
 				},
 				{
-					start:  31,
-					middle: 34,
-					end:    36,
+					start:    31,
+					ancestor: -1,
+					middle:   34,
+					end:      36,
+				},
+			},
+		},
+		{
+			name: "diff3 conflicts",
+			content: `<<<<<<< HEAD
+foo
+||||||| merged common ancestors
+bar
+=======
+baz
+>>>>>>> branch
+
+++<<<<<<< ours
+foo
+++||||||| base
+++=======
+baz
+++>>>>>>> theirs
+`,
+			expected: []*mergeConflict{
+				{
+					start:    0,
+					ancestor: 2,
+					middle:   4,
+					end:      6,
+				},
+				{
+					start:    8,
+					ancestor: 10,
+					middle:   11,
+					end:      13,
+				},
+			},
+		},
+		{
+			name: "markers outside of a conflict",
+			content: `Title
+=======
+
+||||||| not a conflict
+>>>>>>> nor this
+
+<<<<<<< HEAD
+foo
+=======
+bar
+>>>>>>> branch
+`,
+			expected: []*mergeConflict{
+				{
+					start:    6,
+					ancestor: -1,
+					middle:   8,
+					end:      10,
 				},
 			},
 		},
@@ -101,3 +163,125 @@ baz
 		})
 	}
 }
+
+func TestIsIndexToDelete(t *testing.T) {
+	type scenario struct {
+		name      string
+		content   string
+		selection Selection
+		expected  string
+	}
+
+	mergeContent := `before
+<<<<<<< HEAD
+ours
+=======
+theirs
+>>>>>>> branch
+after
+`
+
+	diff3Content := `before
+<<<<<<< HEAD
+ours
+||||||| merged common ancestors
+base
+=======
+theirs
+>>>>>>> branch
+after
+`
+
+	scenarios := []scenario{
+		{
+			name:      "merge style, picking top",
+			content:   mergeContent,
+			selection: TOP,
+			expected:  "before\nours\nafter\n",
+		},
+		{
+			name:      "merge style, picking bottom",
+			content:   mergeContent,
+			selection: BOTTOM,
+			expected:  "before\ntheirs\nafter\n",
+		},
+		{
+			name:      "merge style, picking both",
+			content:   mergeContent,
+			selection: BOTH,
+			expected:  "before\nours\ntheirs\nafter\n",
+		},
+		{
+			name:      "diff3 style, picking top",
+			content:   diff3Content,
+			selection: TOP,
+			expected:  "before\nours\nafter\n",
+		},
+		{
+			name:      "diff3 style, picking base",
+			content:   diff3Content,
+			selection: BASE,
+			expected:  "before\nbase\nafter\n",
+		},
+		{
+			name:      "diff3 style, picking bottom",
+			content:   diff3Content,
+			selection: BOTTOM,
+			expected:  "before\ntheirs\nafter\n",
+		},
+		{
+			name:      "diff3 style, picking both",
+			content:   diff3Content,
+			selection: BOTH,
+			expected:  "before\nours\ntheirs\nafter\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			conflict := findConflicts(s.content)[0]
+
+			result := ""
+			for i, line := range strings.SplitAfter(s.content, "\n") {
+				if !isIndexToDelete(i, conflict, s.selection) {
+					result += line
+				}
+			}
+
+			assert.EqualValues(t, s.expected, result)
+		})
+	}
+}
+
+func TestSelectOption(t *testing.T) {
+	state := NewState()
+	state.SetConflictsFromCat(`<<<<<<< HEAD
+ours
+||||||| base
+base
+=======
+theirs
+>>>>>>> branch
+<<<<<<< HEAD
+ours
+=======
+theirs
+>>>>>>> branch
+`)
+
+	assert.EqualValues(t, TOP, state.Selection())
+	state.SelectNextOption()
+	assert.EqualValues(t, BASE, state.Selection())
+	state.SelectNextOption()
+	assert.EqualValues(t, BOTTOM, state.Selection())
+	state.SelectNextOption()
+	assert.EqualValues(t, BOTTOM, state.Selection())
+
+	// the second conflict has no base hunk
+	state.SelectPrevOption()
+	state.SelectNextConflict()
+	assert.EqualValues(t, BOTTOM, state.Selection())
+	state.SelectPrevOption()
+	assert.EqualValues(t, TOP, state.Selection())
+}
@@ -2,6 +2,7 @@ package gui
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/fatih/color"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -57,5 +58,53 @@ func (gui *Gui) modeStatuses() []modeStatus {
 			},
 			reset: gui.exitCherryPickingMode,
 		},
+		{
+			isActive: gui.State.Modes.Bisecting.Active,
+			description: func() string {
+				return utils.ColoredString(
+					fmt.Sprintf("%s %s", gui.Tr.LcBisecting, utils.ColoredString(gui.Tr.ResetInParentheses, color.Underline)),
+					color.FgGreen,
+				)
+			},
+			reset: gui.guardMutating(gui.resetBisect),
+		},
+		{
+			isActive: gui.State.Modes.Splitting.Active,
+			description: func() string {
+				return utils.ColoredString(
+					fmt.Sprintf(
+						"%s %s %s",
+						utils.ResolvePlaceholderString(
+							gui.Tr.LcSplittingCommit,
+							map[string]string{
+								"commit": utils.SafeTruncate(gui.State.Modes.Splitting.GetSha(), 8),
+								"count":  strconv.Itoa(gui.State.Modes.Splitting.GetCommitCount()),
+							},
+						),
+						gui.Tr.LcSplitCommitHint,
+						utils.ColoredString(gui.Tr.ResetInParentheses, color.Underline),
+					),
+					color.FgYellow,
+				)
+			},
+			reset: gui.guardMutating(gui.abortSplitCommit),
+		},
+		{
+			isActive: gui.State.Modes.RebaseOnto.Active,
+			description: func() string {
+				return utils.ColoredString(
+					fmt.Sprintf(
+						"%s %s",
+						utils.ResolvePlaceholderString(
+							gui.Tr.LcSelectNewBaseForRebaseOnto,
+							map[string]string{"upstream": utils.SafeTruncate(gui.State.Modes.RebaseOnto.GetUpstream(), 8)},
+						),
+						utils.ColoredString(gui.Tr.ResetInParentheses, color.Underline),
+					),
+					color.FgBlue,
+				)
+			},
+			reset: gui.exitRebaseOntoMode,
+		},
 	}
 }
@@ -0,0 +1,23 @@
+package bisecting
+
+import "github.com/jesseduffield/lazygit/pkg/commands/models"
+
+type Bisecting struct {
+	info *models.BisectInfo // refreshed alongside the commits
+}
+
+func New() Bisecting {
+	return Bisecting{info: models.NewBisectInfo()}
+}
+
+func (m *Bisecting) Active() bool {
+	return m.info.Bisecting()
+}
+
+func (m *Bisecting) SetInfo(info *models.BisectInfo) {
+	m.info = info
+}
+
+func (m *Bisecting) GetInfo() *models.BisectInfo {
+	return m.info
+}
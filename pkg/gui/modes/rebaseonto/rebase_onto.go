@@ -0,0 +1,28 @@
+package rebaseonto
+
+// RebaseOnto is for when we've marked the upstream of a 'git rebase --onto' and
+// are waiting for the new base to be chosen. The commits after the upstream, up
+// to HEAD, are the ones that will be moved onto the new base.
+type RebaseOnto struct {
+	upstream string
+}
+
+func New() RebaseOnto {
+	return RebaseOnto{}
+}
+
+func (m *RebaseOnto) Active() bool {
+	return m.upstream != ""
+}
+
+func (m *RebaseOnto) Start(upstream string) {
+	m.upstream = upstream
+}
+
+func (m *RebaseOnto) Reset() {
+	*m = New()
+}
+
+func (m *RebaseOnto) GetUpstream() string {
+	return m.upstream
+}
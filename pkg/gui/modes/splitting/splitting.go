@@ -0,0 +1,44 @@
+package splitting
+
+// Splitting is for when we're part way through splitting a commit into several
+// commits. The commit has been undone mid-rebase, and its changes are waiting
+// to be committed again in pieces.
+type Splitting struct {
+	sha         string // the commit being split
+	message     string // the commit's original message, which each piece starts from
+	commitCount int    // how many pieces we've committed so far
+}
+
+func New() Splitting {
+	return Splitting{}
+}
+
+func (m *Splitting) Active() bool {
+	return m.sha != ""
+}
+
+func (m *Splitting) Start(sha string, message string) {
+	m.sha = sha
+	m.message = message
+	m.commitCount = 0
+}
+
+func (m *Splitting) Reset() {
+	*m = New()
+}
+
+func (m *Splitting) GetSha() string {
+	return m.sha
+}
+
+func (m *Splitting) GetMessage() string {
+	return m.message
+}
+
+func (m *Splitting) IncrementCommitCount() {
+	m.commitCount++
+}
+
+func (m *Splitting) GetCommitCount() int {
+	return m.commitCount
+}
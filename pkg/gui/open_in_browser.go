@@ -0,0 +1,68 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
+
+// these open things in the web UI of the service that the origin remote is
+// hosted on, using the URL templates of the matching git service
+
+// handleOpenCommitInBrowser works for any of the commits contexts, because
+// their items' ids are the commits' shas
+func (gui *Gui) handleOpenCommitInBrowser() error {
+	sha := gui.getSideContextSelectedItemId()
+	if sha == "" {
+		return nil
+	}
+
+	return gui.openInBrowser(func(pullRequest *commands.PullRequest) (string, error) {
+		return pullRequest.OpenCommit(sha)
+	})
+}
+
+func (gui *Gui) handleOpenCommitFileInBrowser() error {
+	node := gui.getSelectedCommitFileNode()
+	if node == nil {
+		return nil
+	}
+
+	return gui.openInBrowser(func(pullRequest *commands.PullRequest) (string, error) {
+		return pullRequest.OpenFile(gui.State.Panels.CommitFiles.refName, node.GetPath())
+	})
+}
+
+// handleOpenSelectedLinesInBrowser opens the lines selected in the staging or
+// patch building view, as of the same commit that we'd take their history from
+func (gui *Gui) handleOpenSelectedLinesInBrowser() error {
+	filter, err := gui.selectedLineRangeFilter()
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+	if filter == nil {
+		return nil
+	}
+
+	return gui.openInBrowser(func(pullRequest *commands.PullRequest) (string, error) {
+		return pullRequest.OpenFileLines(filter.ref, filter.path, filter.firstLine, filter.lastLine)
+	})
+}
+
+func (gui *Gui) handleOpenTagInBrowser(tag *models.Tag) error {
+	return gui.openInBrowser(func(pullRequest *commands.PullRequest) (string, error) {
+		return pullRequest.OpenTag(tag.Name)
+	})
+}
+
+func (gui *Gui) openInBrowser(open func(*commands.PullRequest) (string, error)) error {
+	url, err := open(commands.NewPullRequest(gui.GitCommand))
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+	gui.OnRunCommand(oscommands.NewCmdLogEntry(fmt.Sprintf("Opening URL: %s", url), gui.Tr.Spans.OpenInBrowser, false))
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package presentation
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// GetBlameDisplayString renders a blamed file, one row per line of the file,
+// with the selected line highlighted
+func GetBlameDisplayString(lines []*models.BlameLine, selectedLineIdx int) string {
+	displayStrings := make([][]string, len(lines))
+
+	for i, line := range lines {
+		// we only show the commit's details on the first of a run of lines from
+		// the same commit, so that it's easier to see where each change begins
+		showCommit := i == 0 || lines[i-1].Commit != line.Commit
+		displayStrings[i] = getBlameDisplayStrings(line, showCommit)
+	}
+
+	rendered := strings.Split(utils.RenderDisplayStrings(displayStrings), "\n")
+	if selectedLineIdx >= 0 && selectedLineIdx < len(rendered) {
+		rendered[selectedLineIdx] = utils.ColoredString(
+			utils.Decolorise(rendered[selectedLineIdx]),
+			color.Bold,
+			theme.SelectedRangeBgColor,
+		)
+	}
+
+	return strings.Join(rendered, "\n")
+}
+
+func getBlameDisplayStrings(line *models.BlameLine, showCommit bool) []string {
+	lineNumber := utils.ColoredString(strconv.Itoa(line.LineNumber), color.FgMagenta)
+	content := utils.ColoredString(line.Content, theme.DefaultTextColor)
+
+	if !showCommit {
+		return []string{"", "", "", lineNumber, content}
+	}
+
+	shaColor := color.FgYellow
+	if line.Commit.IsUncommitted() {
+		shaColor = color.FgRed
+	}
+
+	return []string{
+		utils.ColoredString(line.Commit.ShortSha(), shaColor),
+		utils.ColoredString(utils.TruncateWithEllipsis(line.Commit.Author, 17), color.FgCyan),
+		utils.ColoredString(utils.UnixToDate(line.Commit.UnixTimestamp), color.FgBlue),
+		lineNumber,
+		content,
+	}
+}
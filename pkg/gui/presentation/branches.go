@@ -36,6 +36,9 @@ func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool
 	if b.IsTrackingRemote() {
 		coloredName = fmt.Sprintf("%s %s", coloredName, ColoredBranchStatus(b))
 	}
+	if b.PullRequest != nil {
+		coloredName = fmt.Sprintf("%s %s", coloredName, ColoredPullRequestStatus(b.PullRequest))
+	}
 
 	recencyColor := color.FgCyan
 	if b.Recency == "  *" {
@@ -79,3 +82,21 @@ func ColoredBranchStatus(branch *models.Branch) string {
 func BranchStatus(branch *models.Branch) string {
 	return fmt.Sprintf("↑%s↓%s", branch.Pushables, branch.Pullables)
 }
+
+// ColoredPullRequestStatus returns something like '#12 open approved'
+func ColoredPullRequestStatus(pullRequest *models.PullRequest) string {
+	stateColour := color.FgCyan
+	if pullRequest.State == models.PULL_REQUEST_DRAFT {
+		stateColour = theme.DefaultTextColor
+	}
+	status := utils.ColoredString(fmt.Sprintf("#%d %s", pullRequest.Number, pullRequest.State), stateColour)
+
+	switch pullRequest.ReviewStatus {
+	case models.REVIEW_APPROVED:
+		status = fmt.Sprintf("%s %s", status, utils.ColoredString(pullRequest.ReviewStatus, color.FgGreen))
+	case models.REVIEW_CHANGES_REQUESTED:
+		status = fmt.Sprintf("%s %s", status, utils.ColoredString(pullRequest.ReviewStatus, color.FgRed))
+	}
+
+	return status
+}
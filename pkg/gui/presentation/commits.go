@@ -5,15 +5,16 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/graph"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/kyokomi/emoji/v2"
 )
 
-func GetCommitListDisplayStrings(commits []*models.Commit, fullDescription bool, cherryPickedCommitShaMap map[string]bool, diffName string, parseEmoji bool) [][]string {
+func GetCommitListDisplayStrings(commits []*models.Commit, fullDescription bool, cherryPickedCommitShaMap map[string]bool, diffName string, parseEmoji bool, bisectInfo *models.BisectInfo, showGraph bool) [][]string {
 	lines := make([][]string, len(commits))
 
-	var displayFunc func(*models.Commit, map[string]bool, bool, bool) []string
+	var displayFunc func(*models.Commit, map[string]bool, bool, bool, *models.BisectInfo) []string
 	if fullDescription {
 		displayFunc = getFullDescriptionDisplayStringsForCommit
 	} else {
@@ -22,13 +23,42 @@ func GetCommitListDisplayStrings(commits []*models.Commit, fullDescription bool,
 
 	for i := range commits {
 		diffed := commits[i].Sha == diffName
-		lines[i] = displayFunc(commits[i], cherryPickedCommitShaMap, diffed, parseEmoji)
+		lines[i] = displayFunc(commits[i], cherryPickedCommitShaMap, diffed, parseEmoji, bisectInfo)
+	}
+
+	// signature statuses are only loaded if the user has asked for them
+	if anyCommitHasSignatureStatus(commits) {
+		for i := range lines {
+			// the marker goes just after the commit's sha
+			lines[i] = append(lines[i][:1], append([]string{signatureMarker(commits[i])}, lines[i][1:]...)...)
+		}
+	}
+
+	if showGraph {
+		graphLines := getGraphLines(commits)
+		for i := range lines {
+			// the graph goes just before the commit's name
+			nameIdx := len(lines[i]) - 1
+			name := lines[i][nameIdx]
+			lines[i] = append(lines[i][:nameIdx], graphLines[i], name)
+		}
 	}
 
 	return lines
 }
 
-func getFullDescriptionDisplayStringsForCommit(c *models.Commit, cherryPickedCommitShaMap map[string]bool, diffed, parseEmoji bool) []string {
+// getGraphLines returns the graph for each commit. Any rebasing commits at the
+// top of the list are yet to be created so they're left out of the graph.
+func getGraphLines(commits []*models.Commit) []string {
+	start := 0
+	for start < len(commits) && commits[start].Status == "rebasing" {
+		start++
+	}
+
+	return append(make([]string, start), graph.RenderCommitGraph(commits[start:])...)
+}
+
+func getFullDescriptionDisplayStringsForCommit(c *models.Commit, cherryPickedCommitShaMap map[string]bool, diffed, parseEmoji bool, bisectInfo *models.BisectInfo) []string {
 	red := color.New(color.FgRed)
 	yellow := color.New(color.FgYellow)
 	green := color.New(color.FgGreen)
@@ -40,6 +70,7 @@ func getFullDescriptionDisplayStringsForCommit(c *models.Commit, cherryPickedCom
 	// horizontally. For the sake of accessibility I'm considering this a feature,
 	// not a bug
 	copied := color.New(color.FgCyan, color.BgBlue)
+	bisectCandidate := color.New(color.FgBlack, color.BgYellow)
 
 	var shaColor *color.Color
 	switch c.Status {
@@ -61,13 +92,17 @@ func getFullDescriptionDisplayStringsForCommit(c *models.Commit, cherryPickedCom
 		shaColor = diffedColor
 	} else if cherryPickedCommitShaMap[c.Sha] {
 		shaColor = copied
+	} else if isBisectCandidate(c, bisectInfo) {
+		shaColor = bisectCandidate
 	}
 
 	tagString := ""
 	secondColumnString := blue.Sprint(utils.UnixToDate(c.UnixTimestamp))
 	if c.Action != "" {
 		secondColumnString = color.New(actionColorMap(c.Action)).Sprint(c.Action)
-	} else if c.ExtraInfo != "" {
+	}
+	// commits yet to be rebased only have extra info if they're branch heads
+	if c.ExtraInfo != "" {
 		tagColor := color.New(color.FgMagenta, color.Bold)
 		tagString = utils.ColoredStringDirect(c.ExtraInfo, tagColor) + " "
 	}
@@ -79,10 +114,10 @@ func getFullDescriptionDisplayStringsForCommit(c *models.Commit, cherryPickedCom
 		name = emoji.Sprint(name)
 	}
 
-	return []string{shaColor.Sprint(c.ShortSha()), secondColumnString, yellow.Sprint(truncatedAuthor), tagString + defaultColor.Sprint(name)}
+	return []string{shaColor.Sprint(c.ShortSha()), secondColumnString, yellow.Sprint(truncatedAuthor), tagString + defaultColor.Sprint(name) + bisectString(c, bisectInfo)}
 }
 
-func getDisplayStringsForCommit(c *models.Commit, cherryPickedCommitShaMap map[string]bool, diffed, parseEmoji bool) []string {
+func getDisplayStringsForCommit(c *models.Commit, cherryPickedCommitShaMap map[string]bool, diffed, parseEmoji bool, bisectInfo *models.BisectInfo) []string {
 	red := color.New(color.FgRed)
 	yellow := color.New(color.FgYellow)
 	green := color.New(color.FgGreen)
@@ -94,6 +129,7 @@ func getDisplayStringsForCommit(c *models.Commit, cherryPickedCommitShaMap map[s
 	// horizontally. For the sake of accessibility I'm considering this a feature,
 	// not a bug
 	copied := color.New(color.FgCyan, color.BgBlue)
+	bisectCandidate := color.New(color.FgBlack, color.BgYellow)
 
 	var shaColor *color.Color
 	switch c.Status {
@@ -115,6 +151,8 @@ func getDisplayStringsForCommit(c *models.Commit, cherryPickedCommitShaMap map[s
 		shaColor = diffedColor
 	} else if cherryPickedCommitShaMap[c.Sha] {
 		shaColor = copied
+	} else if isBisectCandidate(c, bisectInfo) {
+		shaColor = bisectCandidate
 	}
 
 	actionString := ""
@@ -131,7 +169,70 @@ func getDisplayStringsForCommit(c *models.Commit, cherryPickedCommitShaMap map[s
 		name = emoji.Sprint(name)
 	}
 
-	return []string{shaColor.Sprint(c.ShortSha()), actionString + tagString + defaultColor.Sprint(name)}
+	return []string{shaColor.Sprint(c.ShortSha()), actionString + tagString + branchHeadsString(c) + defaultColor.Sprint(name) + bisectString(c, bisectInfo)}
+}
+
+// branchHeadsString marks the other local branches whose heads will move along
+// with the commit when it's rebased
+func branchHeadsString(c *models.Commit) string {
+	if len(c.BranchHeads) == 0 {
+		return ""
+	}
+
+	return utils.ColoredString("* "+strings.Join(c.BranchHeads, " "), color.FgCyan, color.Bold) + " "
+}
+
+func anyCommitHasSignatureStatus(commits []*models.Commit) bool {
+	for _, commit := range commits {
+		if commit.SignatureStatus != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// signatureMarker shows whether a commit's signature is good, bad, or can't be
+// trusted (e.g. because the key is unknown or has expired). Unsigned commits
+// get no marker.
+func signatureMarker(c *models.Commit) string {
+	switch c.SignatureStatus {
+	case "G":
+		return utils.ColoredString("✓", color.FgGreen)
+	case "B", "R":
+		return utils.ColoredString("✗", color.FgRed)
+	case "U", "X", "Y", "E":
+		return utils.ColoredString("?", color.FgYellow)
+	default:
+		return ""
+	}
+}
+
+func isBisectCandidate(c *models.Commit, bisectInfo *models.BisectInfo) bool {
+	return bisectInfo != nil && bisectInfo.Bisecting() && c.Sha == bisectInfo.Current
+}
+
+// bisectString returns a marker to be placed after a commit's name, showing
+// how that commit has been marked in the current bisect
+func bisectString(c *models.Commit, bisectInfo *models.BisectInfo) string {
+	if bisectInfo == nil || !bisectInfo.Bisecting() {
+		return ""
+	}
+
+	switch bisectInfo.Status(c.Sha) {
+	case models.BisectStatusBad:
+		return utils.ColoredString(" <-- "+bisectInfo.NewTerm, color.FgRed)
+	case models.BisectStatusGood:
+		return utils.ColoredString(" <-- "+bisectInfo.OldTerm, color.FgGreen)
+	case models.BisectStatusSkipped:
+		return utils.ColoredString(" <-- skipped", color.FgMagenta)
+	}
+
+	if c.Sha == bisectInfo.Current {
+		return utils.ColoredString(" <-- current", color.FgYellow, color.Bold)
+	}
+
+	return ""
 }
 
 func actionColorMap(str string) color.Attribute {
@@ -0,0 +1,299 @@
+package graph
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The graph is drawn as a set of lanes, each of which is waiting on a
+// particular commit to appear further down the list. Every commit takes one row
+// so that rows line up with the commits in the list (and remain selectable):
+// when a commit appears, any lanes waiting on it are joined into the commit's
+// lane, which then goes on to wait on the commit's first parent. Any further
+// parents (i.e. for a merge commit) either join an existing lane already
+// waiting on that parent, or start a new lane.
+
+const (
+	commitSymbol      = "◯"
+	mergeCommitSymbol = "⏣"
+)
+
+var laneColors = []color.Attribute{
+	color.FgCyan,
+	color.FgGreen,
+	color.FgYellow,
+	color.FgBlue,
+	color.FgMagenta,
+	color.FgRed,
+}
+
+type lane struct {
+	// the sha of the commit the lane is waiting on. Empty if the lane is free
+	sha   string
+	color color.Attribute
+}
+
+// a connection is a horizontal line drawn between the commit and another lane
+type connection struct {
+	laneIdx int
+	color   color.Attribute
+}
+
+// cell describes what's drawn in a lane for a given row, in terms of which
+// directions lines leave the centre of the cell in
+type cell struct {
+	up, down, left, right bool
+	isCommit              bool
+	isMerge               bool
+	color                 color.Attribute
+	// the colour of the horizontal line to the right of the cell
+	rightColor color.Attribute
+}
+
+// RenderCommitGraph returns one graph string for each commit. Commits are
+// expected in the order that git log gives them i.e. children before parents.
+func RenderCommitGraph(commits []*models.Commit) []string {
+	result := make([]string, len(commits))
+
+	lanes := []lane{}
+	for i, commit := range commits {
+		var cells []cell
+		lanes, cells = renderRow(lanes, commit)
+		result[i] = renderCells(cells)
+	}
+
+	return result
+}
+
+func renderRow(prevLanes []lane, commit *models.Commit) ([]lane, []cell) {
+	commitLaneIdx := -1
+	closingLaneIdxs := []int{}
+	for i, l := range prevLanes {
+		if l.sha != commit.Sha {
+			continue
+		}
+		if commitLaneIdx == -1 {
+			commitLaneIdx = i
+		} else {
+			closingLaneIdxs = append(closingLaneIdxs, i)
+		}
+	}
+
+	lanes := make([]lane, len(prevLanes))
+	copy(lanes, prevLanes)
+
+	if commitLaneIdx == -1 {
+		// nothing below us has referenced this commit yet, so it's the head of
+		// a new line of history
+		commitLaneIdx = firstFreeLane(lanes)
+		lanes = setLane(lanes, commitLaneIdx, lane{color: colorForSha(commit.Sha)})
+	}
+	commitColor := lanes[commitLaneIdx].color
+
+	connections := []connection{}
+	for _, idx := range closingLaneIdxs {
+		connections = append(connections, connection{laneIdx: idx, color: lanes[idx].color})
+		lanes[idx] = lane{}
+	}
+
+	parents := nonEmpty(commit.Parents)
+	if len(parents) == 0 {
+		lanes[commitLaneIdx] = lane{}
+	} else {
+		lanes[commitLaneIdx].sha = parents[0]
+
+		for _, parent := range parents[1:] {
+			idx := findLane(lanes, parent)
+			if idx == -1 {
+				idx = firstFreeLane(lanes)
+				lanes = setLane(lanes, idx, lane{sha: parent, color: colorForSha(parent)})
+			}
+			connections = append(connections, connection{laneIdx: idx, color: lanes[idx].color})
+		}
+	}
+
+	lanes = trimFreeLanes(lanes)
+
+	// a root commit's lane may have been trimmed, but we still need to draw it
+	width := utils.Max(utils.Max(len(prevLanes), len(lanes)), commitLaneIdx+1)
+	cells := make([]cell, width)
+	for i := range cells {
+		cells[i].up = i < len(prevLanes) && prevLanes[i].sha != ""
+		cells[i].down = i < len(lanes) && lanes[i].sha != ""
+		if cells[i].down {
+			cells[i].color = lanes[i].color
+		} else if cells[i].up {
+			cells[i].color = prevLanes[i].color
+		}
+	}
+
+	cells[commitLaneIdx].isCommit = true
+	cells[commitLaneIdx].isMerge = len(parents) > 1
+	cells[commitLaneIdx].color = commitColor
+
+	drawConnections(cells, commitLaneIdx, connections)
+
+	return lanes, cells
+}
+
+// drawConnections draws horizontal lines from the commit's lane out to each
+// connected lane. Where two connections overlap, the nearer one's colour wins.
+func drawConnections(cells []cell, commitLaneIdx int, connections []connection) {
+	// drawing the furthest connections first so that nearer ones are drawn on top
+	sort.SliceStable(connections, func(i, j int) bool {
+		return distance(connections[i].laneIdx, commitLaneIdx) > distance(connections[j].laneIdx, commitLaneIdx)
+	})
+
+	for _, conn := range connections {
+		start, end := commitLaneIdx, conn.laneIdx
+		if start > end {
+			start, end = end, start
+		}
+
+		for i := start; i <= end; i++ {
+			if i < end {
+				cells[i].right = true
+				cells[i].rightColor = conn.color
+			}
+			if i > start {
+				cells[i].left = true
+			}
+
+			if i == commitLaneIdx {
+				continue
+			}
+			if i == conn.laneIdx || !(cells[i].up && cells[i].down) {
+				// where lanes pass straight through we keep their colour, otherwise
+				// the line takes on the connection's colour
+				cells[i].color = conn.color
+			}
+		}
+	}
+}
+
+func distance(a, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func renderCells(cells []cell) string {
+	var builder strings.Builder
+	for _, c := range cells {
+		if symbol := c.symbol(); symbol == " " {
+			builder.WriteString(symbol)
+		} else {
+			builder.WriteString(utils.ColoredString(symbol, c.color))
+		}
+		if c.right {
+			builder.WriteString(utils.ColoredString("─", c.rightColor))
+		} else {
+			builder.WriteString(" ")
+		}
+	}
+
+	return strings.TrimRight(builder.String(), " ")
+}
+
+func (c cell) symbol() string {
+	if c.isCommit {
+		if c.isMerge {
+			return mergeCommitSymbol
+		}
+		return commitSymbol
+	}
+
+	switch {
+	case c.up && c.down && c.left && c.right:
+		return "┼"
+	case c.up && c.down && c.left:
+		return "┤"
+	case c.up && c.down && c.right:
+		return "├"
+	case c.up && c.left && c.right:
+		return "┴"
+	case c.down && c.left && c.right:
+		return "┬"
+	case c.up && c.left:
+		return "╯"
+	case c.up && c.right:
+		return "╰"
+	case c.down && c.left:
+		return "╮"
+	case c.down && c.right:
+		return "╭"
+	case c.up || c.down:
+		return "│"
+	case c.left || c.right:
+		return "─"
+	default:
+		return " "
+	}
+}
+
+func findLane(lanes []lane, sha string) int {
+	for i, l := range lanes {
+		if l.sha == sha {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// firstFreeLane returns the index of the first free lane, which may be one past
+// the end of the existing lanes
+func firstFreeLane(lanes []lane) int {
+	idx := findLane(lanes, "")
+	if idx == -1 {
+		return len(lanes)
+	}
+
+	return idx
+}
+
+func setLane(lanes []lane, idx int, l lane) []lane {
+	if idx == len(lanes) {
+		return append(lanes, l)
+	}
+
+	lanes[idx] = l
+	return lanes
+}
+
+func trimFreeLanes(lanes []lane) []lane {
+	end := len(lanes)
+	for end > 0 && lanes[end-1].sha == "" {
+		end--
+	}
+
+	return lanes[:end]
+}
+
+// nonEmpty filters out empty parent shas, which is what we get from splitting
+// the parents field of a root commit
+func nonEmpty(shas []string) []string {
+	result := make([]string, 0, len(shas))
+	for _, sha := range shas {
+		if sha != "" {
+			result = append(result, sha)
+		}
+	}
+
+	return result
+}
+
+// colorForSha picks a colour for a new lane based on the sha it starts from, so
+// that a line of history keeps the same colour as more commits are loaded
+func colorForSha(sha string) color.Attribute {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(sha))
+
+	return laneColors[hash.Sum32()%uint32(len(laneColors))]
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRenderCommitGraph is a function.
+func TestRenderCommitGraph(t *testing.T) {
+	type scenario struct {
+		testName string
+		commits  []*models.Commit
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Linear history",
+			commits: []*models.Commit{
+				{Sha: "3", Parents: []string{"2"}},
+				{Sha: "2", Parents: []string{"1"}},
+				{Sha: "1", Parents: []string{""}},
+			},
+			expected: `
+◯
+◯
+◯`,
+		},
+		{
+			testName: "Unrelated histories",
+			commits: []*models.Commit{
+				{Sha: "2", Parents: []string{""}},
+				{Sha: "1", Parents: []string{""}},
+			},
+			expected: `
+◯
+◯`,
+		},
+		{
+			testName: "Merged branch",
+			commits: []*models.Commit{
+				{Sha: "5", Parents: []string{"4", "3"}},
+				{Sha: "4", Parents: []string{"2"}},
+				{Sha: "3", Parents: []string{"2"}},
+				{Sha: "2", Parents: []string{"1"}},
+				{Sha: "1", Parents: []string{""}},
+			},
+			expected: `
+⏣─╮
+◯ │
+│ ◯
+◯─╯
+◯`,
+		},
+		{
+			testName: "Two branch heads",
+			commits: []*models.Commit{
+				{Sha: "3", Parents: []string{"1"}},
+				{Sha: "2", Parents: []string{"1"}},
+				{Sha: "1", Parents: []string{""}},
+			},
+			expected: `
+◯
+│ ◯
+◯─╯`,
+		},
+		{
+			testName: "Merge joins an existing lane",
+			commits: []*models.Commit{
+				{Sha: "6", Parents: []string{"5"}},
+				{Sha: "7", Parents: []string{"3"}},
+				{Sha: "5", Parents: []string{"4", "3"}},
+				{Sha: "4", Parents: []string{"2"}},
+				{Sha: "3", Parents: []string{"2"}},
+				{Sha: "2", Parents: []string{""}},
+			},
+			expected: `
+◯
+│ ◯
+⏣─┤
+◯ │
+│ ◯
+◯─╯`,
+		},
+		{
+			testName: "Lanes crossing",
+			commits: []*models.Commit{
+				{Sha: "8", Parents: []string{"5", "7"}},
+				{Sha: "9", Parents: []string{"6"}},
+				{Sha: "7", Parents: []string{"6"}},
+				{Sha: "5", Parents: []string{"6"}},
+				{Sha: "6", Parents: []string{""}},
+			},
+			expected: `
+⏣─╮
+│ │ ◯
+│ ◯ │
+◯ │ │
+◯─┴─╯`,
+		},
+		{
+			testName: "Parent outside of the loaded commits",
+			commits: []*models.Commit{
+				{Sha: "3", Parents: []string{"2", "1"}},
+				{Sha: "2", Parents: []string{"0"}},
+			},
+			expected: `
+⏣─╮
+◯ │`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			lines := RenderCommitGraph(s.commits)
+			for i := range lines {
+				lines[i] = utils.Decolorise(lines[i])
+			}
+			assert.EqualValues(t, strings.TrimPrefix(s.expected, "\n"), strings.Join(lines, "\n"))
+		})
+	}
+}
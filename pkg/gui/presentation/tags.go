@@ -1,6 +1,9 @@
 package presentation
 
 import (
+	"strings"
+
+	"github.com/fatih/color"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -23,5 +26,9 @@ func getTagDisplayStrings(t *models.Tag, diffed bool) []string {
 	if diffed {
 		attr = theme.DiffTerminalColor
 	}
-	return []string{utils.ColoredString(t.Name, attr)}
+
+	// lightweight tags have no message of their own, so we leave this column blank
+	subject := strings.SplitN(t.Message, "\n", 2)[0]
+
+	return []string{utils.ColoredString(t.Name, attr), utils.ColoredString(subject, color.FgYellow)}
 }
@@ -0,0 +1,58 @@
+package presentation
+
+import (
+	"github.com/fatih/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+func GetWorktreeListDisplayStrings(worktrees []*models.Worktree) [][]string {
+	lines := make([][]string, len(worktrees))
+
+	for i := range worktrees {
+		lines[i] = getWorktreeDisplayStrings(worktrees[i])
+	}
+
+	return lines
+}
+
+// getWorktreeDisplayStrings returns the display string of a worktree
+func getWorktreeDisplayStrings(w *models.Worktree) []string {
+	current := ""
+	if w.IsCurrent {
+		current = "*"
+	}
+
+	nameColor := theme.DefaultTextColor
+	if w.IsCurrent {
+		nameColor = color.FgGreen
+	} else if w.Prunable {
+		nameColor = color.FgRed
+	}
+	name := w.Name()
+	if w.IsMain {
+		name += " (main)"
+	}
+
+	ref := ""
+	if w.Branch != "" {
+		ref = utils.ColoredString(w.Branch, GetBranchColor(w.Branch))
+	} else if w.Head != "" {
+		ref = utils.ColoredString(utils.SafeTruncate(w.Head, 8), color.FgBlue)
+	}
+
+	status := ""
+	if w.Locked {
+		status = utils.ColoredString("locked", color.FgYellow)
+	} else if w.Prunable {
+		status = utils.ColoredString("prunable", color.FgRed)
+	}
+
+	return []string{
+		utils.ColoredString(current, color.FgGreen),
+		utils.ColoredString(name, nameColor),
+		ref,
+		status,
+	}
+}
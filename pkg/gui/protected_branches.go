@@ -0,0 +1,129 @@
+package gui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Branches matching a pattern in the git.protectedBranches config can have
+// force pushing, hard resetting, rebasing, and deleting either blocked, or
+// allowed only once the user has typed the branch's name to confirm.
+
+type BranchProtectionAction int
+
+const (
+	FORCE_PUSH BranchProtectionAction = iota
+	HARD_RESET
+	REBASE_BRANCH
+	DELETE_BRANCH
+)
+
+// branchProtection returns the strictest protection that any matching pattern
+// sets for the action
+func (gui *Gui) branchProtection(branchName string, action BranchProtectionAction) string {
+	result := config.PROTECTION_ALLOW
+	for _, protectedBranch := range gui.protectedBranchRules() {
+		if !utils.MatchGlob(protectedBranch.Pattern, branchName) {
+			continue
+		}
+
+		protection := protectionForAction(protectedBranch, action)
+		if protection == config.PROTECTION_BLOCK {
+			return config.PROTECTION_BLOCK
+		}
+		if protection == config.PROTECTION_CONFIRM {
+			result = config.PROTECTION_CONFIRM
+		}
+	}
+
+	return result
+}
+
+// protectedBranchRules returns the configured rules, plus a rule blocking force
+// pushes to every branch if the deprecated disableForcePushing option is on
+func (gui *Gui) protectedBranchRules() []config.ProtectedBranchConfig {
+	gitConfig := gui.Config.GetUserConfig().Git
+	if !gitConfig.DisableForcePushing {
+		return gitConfig.ProtectedBranches
+	}
+
+	return append(
+		[]config.ProtectedBranchConfig{{Pattern: "**", ForcePush: config.PROTECTION_BLOCK}},
+		gitConfig.ProtectedBranches...,
+	)
+}
+
+// protectionForAction treats a setting it doesn't know as 'block', to be safe.
+// Those are rejected when the config is loaded anyway.
+func protectionForAction(protectedBranch config.ProtectedBranchConfig, action BranchProtectionAction) string {
+	protection := ""
+	switch action {
+	case FORCE_PUSH:
+		protection = protectedBranch.ForcePush
+	case HARD_RESET:
+		protection = protectedBranch.HardReset
+	case REBASE_BRANCH:
+		protection = protectedBranch.Rebase
+	case DELETE_BRANCH:
+		protection = protectedBranch.Delete
+	}
+
+	if normalized := config.NormalizeProtection(protection); normalized != "" {
+		return normalized
+	}
+	return config.PROTECTION_BLOCK
+}
+
+func (gui *Gui) branchProtectionActionName(action BranchProtectionAction) string {
+	switch action {
+	case FORCE_PUSH:
+		return gui.Tr.LcForcePush
+	case HARD_RESET:
+		return gui.Tr.LcHardReset
+	case REBASE_BRANCH:
+		return gui.Tr.LcRebase
+	case DELETE_BRANCH:
+		return gui.Tr.LcDelete
+	}
+
+	return ""
+}
+
+// withBranchProtection calls f unless the action is blocked for the branch. If
+// the action requires confirmation, f is only called once the user has typed
+// the branch's name.
+func (gui *Gui) withBranchProtection(branchName string, action BranchProtectionAction, f func() error) error {
+	placeholders := map[string]string{
+		"branch": branchName,
+		"action": gui.branchProtectionActionName(action),
+	}
+
+	switch gui.branchProtection(branchName, action) {
+	case config.PROTECTION_BLOCK:
+		return gui.createErrorPanel(utils.ResolvePlaceholderString(gui.Tr.ProtectedBranchBlocked, placeholders))
+	case config.PROTECTION_CONFIRM:
+		return gui.prompt(promptOpts{
+			title: utils.ResolvePlaceholderString(gui.Tr.ProtectedBranchConfirmTitle, placeholders),
+			handleConfirm: func(response string) error {
+				if response != branchName {
+					return gui.createErrorPanel(utils.ResolvePlaceholderString(gui.Tr.ProtectedBranchConfirmMismatch, placeholders))
+				}
+
+				return f()
+			},
+		})
+	}
+
+	return f()
+}
+
+// withCheckedOutBranchProtection is withBranchProtection for the checked out
+// branch, e.g. for actions that rewrite its commits
+func (gui *Gui) withCheckedOutBranchProtection(action BranchProtectionAction, f func() error) error {
+	checkedOutBranch := gui.getCheckedOutBranch()
+	if checkedOutBranch == nil || gui.GitCommand.IsHeadDetached() {
+		return f()
+	}
+
+	return gui.withBranchProtection(checkedOutBranch.Name, action, f)
+}
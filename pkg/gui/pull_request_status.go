@@ -0,0 +1,63 @@
+package gui
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// pullRequestsState is shared between the pull request status refresher and
+// the branches panel, and only to be accessed while holding
+// gui.Mutexes.PullRequestsMutex
+type pullRequestsState struct {
+	// the open pull requests on the hosting service, keyed by source branch
+	byBranch map[string]*models.PullRequest
+	// the local branches to get pull requests for, as of the last time the
+	// branches panel was refreshed
+	branchNames []string
+	// set when the hosting service's API tells us we've made too many
+	// requests, and we don't ask again until then
+	backoffUntil time.Time
+}
+
+// startPullRequestStatusRefresh keeps the branches panel's pull request info up
+// to date by asking the hosting service's API every fetchInterval seconds
+func (gui *Gui) startPullRequestStatusRefresh() {
+	gui.waitForIntro.Wait()
+
+	_ = gui.refreshPullRequests()
+
+	interval := time.Second * time.Duration(gui.Config.GetUserConfig().Refresher.FetchInterval)
+	gui.goEvery(interval, gui.stopChan, gui.refreshPullRequests)
+}
+
+// refreshPullRequests only logs errors because it runs in the background, and a
+// popup every minute because you're offline would get old fast
+func (gui *Gui) refreshPullRequests() error {
+	gui.Mutexes.PullRequestsMutex.Lock()
+	backoffUntil := gui.State.PullRequests.backoffUntil
+	branchNames := gui.State.PullRequests.branchNames
+	gui.Mutexes.PullRequestsMutex.Unlock()
+
+	if time.Now().Before(backoffUntil) {
+		return nil
+	}
+
+	pullRequests, err := commands.NewPullRequest(gui.GitCommand).GetOpenPullRequests(branchNames)
+
+	gui.Mutexes.PullRequestsMutex.Lock()
+	if err == nil {
+		gui.State.PullRequests.byBranch = pullRequests
+	} else if rateLimitErr, ok := err.(*commands.ForgeRateLimitError); ok {
+		gui.State.PullRequests.backoffUntil = rateLimitErr.RetryAfter
+	}
+	gui.Mutexes.PullRequestsMutex.Unlock()
+
+	if err != nil {
+		gui.Log.Error(err)
+		return err
+	}
+
+	return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{BRANCHES}})
+}
@@ -0,0 +1,38 @@
+package gui
+
+// In read-only mode, lazygit can be used to browse history, diffs and blame,
+// but every binding or mouse click that would change the repo shows an error
+// instead. Custom commands could do anything, so they're all treated as
+// changing the repo.
+
+// guardMutatingBindings swaps out the handlers of mutating bindings for one
+// which explains why they're disabled, if we're in read-only mode
+func (gui *Gui) guardMutatingBindings(bindings []*Binding) []*Binding {
+	if !gui.Config.GetReadOnly() {
+		return bindings
+	}
+
+	for _, binding := range bindings {
+		if binding.Mutating {
+			binding.Handler = gui.handleReadOnlyBinding
+		}
+	}
+
+	return bindings
+}
+
+// guardMutating is for handlers that change the repo but aren't bound to a key,
+// e.g. because they're run by clicking on something
+func (gui *Gui) guardMutating(handler func() error) func() error {
+	return func() error {
+		if gui.Config.GetReadOnly() {
+			return gui.handleReadOnlyBinding()
+		}
+
+		return handler()
+	}
+}
+
+func (gui *Gui) handleReadOnlyBinding() error {
+	return gui.createErrorPanel(gui.Tr.ReadOnlyModeError)
+}
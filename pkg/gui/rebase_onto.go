@@ -0,0 +1,92 @@
+package gui
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Rebasing onto a new base takes two steps: first the user marks the upstream,
+// i.e. the ref after which our commits begin, and then they pick the new base to
+// move those commits onto. Either can be picked from a branch, tag, or commit.
+
+func (gui *Gui) handleRebaseOnto() error {
+	if ok, err := gui.validateNotInFilterMode(); err != nil || !ok {
+		return err
+	}
+
+	ref := gui.selectedRebaseOntoRef()
+	if ref == "" {
+		return nil
+	}
+
+	if !gui.State.Modes.RebaseOnto.Active() {
+		gui.State.Modes.RebaseOnto.Start(ref)
+		return gui.refreshSidePanels(refreshOptions{mode: ASYNC})
+	}
+
+	return gui.confirmRebaseOnto(ref, gui.State.Modes.RebaseOnto.GetUpstream())
+}
+
+// selectedRebaseOntoRef returns the ref of the selected branch, tag, or commit
+func (gui *Gui) selectedRebaseOntoRef() string {
+	context := gui.currentSideListContext()
+	if context == nil {
+		return ""
+	}
+
+	item, ok := context.GetSelectedItem()
+	if !ok {
+		return ""
+	}
+
+	return item.ID()
+}
+
+func (gui *Gui) confirmRebaseOnto(newBase string, upstream string) error {
+	if ok, err := gui.validateNormalWorkingTreeState(); !ok {
+		return err
+	}
+
+	if newBase == upstream {
+		return gui.createErrorPanel(gui.Tr.CantRebaseOntoUpstream)
+	}
+
+	commits, err := gui.GitCommand.GetRebaseOntoCommits(upstream)
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	if len(commits) == 0 {
+		return gui.createErrorPanel(gui.Tr.NoCommitsToRebaseOnto)
+	}
+
+	prompt := utils.ResolvePlaceholderString(
+		gui.Tr.ConfirmRebaseOnto,
+		map[string]string{
+			"newBase":  newBase,
+			"upstream": upstream,
+			"commits":  strings.Join(commits, "\n"),
+		},
+	)
+
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.RebaseOntoTitle,
+			prompt: prompt,
+			handleConfirm: func() error {
+				gui.State.Modes.RebaseOnto.Reset()
+
+				return gui.WithWaitingStatus(gui.Tr.RebasingStatus, func() error {
+					err := gui.GitCommand.WithSpan(gui.Tr.Spans.RebaseOnto).RebaseOnto(newBase, upstream)
+					return gui.handleGenericMergeCommandResult(err)
+				})
+			},
+		})
+	})
+}
+
+func (gui *Gui) exitRebaseOntoMode() error {
+	gui.State.Modes.RebaseOnto.Reset()
+	return gui.refreshSidePanels(refreshOptions{mode: ASYNC})
+}
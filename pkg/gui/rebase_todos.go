@@ -0,0 +1,91 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
+
+// During an interactive rebase, alongside changing what happens to each commit
+// we can add exec and break lines to the todo. A new line goes above the
+// selected todo in the commits panel, meaning it runs right after that todo.
+
+func (gui *Gui) handleInsertExecTodo() error {
+	index, ok, err := gui.rebaseTodoInsertIndex()
+	if err != nil || !ok {
+		return err
+	}
+
+	return gui.prompt(promptOpts{
+		title: gui.Tr.InsertExecTodoTitle,
+		handleConfirm: func(command string) error {
+			if command == "" {
+				return nil
+			}
+
+			return gui.insertRebaseTodo(index, "exec "+command)
+		},
+	})
+}
+
+func (gui *Gui) handleInsertBreakTodo() error {
+	index, ok, err := gui.rebaseTodoInsertIndex()
+	if err != nil || !ok {
+		return err
+	}
+
+	return gui.insertRebaseTodo(index, "break")
+}
+
+// rebaseTodoInsertIndex returns the index of the selected commit, so long as
+// we're in an interactive rebase and it's either a todo or the commit we're
+// currently at
+func (gui *Gui) rebaseTodoInsertIndex() (int, bool, error) {
+	if ok, err := gui.validateNotInFilterMode(); err != nil || !ok {
+		return 0, false, err
+	}
+
+	rebaseMode, err := gui.GitCommand.RebaseMode()
+	if err != nil {
+		return 0, false, gui.surfaceError(err)
+	}
+	if rebaseMode != commands.REBASE_MODE_INTERACTIVE {
+		return 0, false, gui.createErrorPanel(gui.Tr.NotInInteractiveRebase)
+	}
+
+	index := gui.State.Panels.Commits.SelectedLineIdx
+	todoCount := 0
+	for _, commit := range gui.State.Commits {
+		if commit.Status != "rebasing" {
+			break
+		}
+		todoCount++
+	}
+
+	if index > todoCount {
+		return 0, false, gui.createErrorPanel(gui.Tr.CantInsertTodoBelowCurrentCommit)
+	}
+
+	return index, true, nil
+}
+
+func (gui *Gui) insertRebaseTodo(index int, todoLine string) error {
+	if err := gui.snapshotRebaseTodo(); err != nil {
+		return gui.surfaceError(err)
+	}
+
+	// logging directly here because InsertRebaseTodo doesn't have enough
+	// information to provide a useful log
+	gui.OnRunCommand(oscommands.NewCmdLogEntry(
+		fmt.Sprintf("Inserting '%s' into rebase TODO", todoLine),
+		gui.Tr.Spans.InsertRebaseTodo,
+		false,
+	))
+
+	if err := gui.GitCommand.InsertRebaseTodo(index, todoLine); err != nil {
+		return gui.surfaceError(err)
+	}
+
+	return gui.refreshRebaseCommits()
+}
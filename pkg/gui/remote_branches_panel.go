@@ -54,17 +54,21 @@ func (gui *Gui) handleDeleteRemoteBranch() error {
 	}
 	message := fmt.Sprintf("%s '%s'?", gui.Tr.DeleteRemoteBranchMessage, remoteBranch.FullName())
 
-	return gui.ask(askOpts{
-		title:  gui.Tr.DeleteRemoteBranch,
-		prompt: message,
-		handleConfirm: func() error {
-			return gui.WithWaitingStatus(gui.Tr.DeletingStatus, func() error {
-				err := gui.GitCommand.WithSpan(gui.Tr.Spans.DeleteRemoteBranch).DeleteRemoteBranch(remoteBranch.RemoteName, remoteBranch.Name, gui.promptUserForCredential)
-				gui.handleCredentialsPopup(err)
-
-				return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{BRANCHES, REMOTES}})
-			})
-		},
+	return gui.withBranchProtection(remoteBranch.Name, DELETE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title:  gui.Tr.DeleteRemoteBranch,
+			prompt: message,
+			handleConfirm: func() error {
+				return gui.WithWaitingStatus(gui.Tr.DeletingStatus, func() error {
+					credentials := gui.newCredentialSession(true)
+					err := gui.GitCommand.WithSpan(gui.Tr.Spans.DeleteRemoteBranch).DeleteRemoteBranch(remoteBranch.RemoteName, remoteBranch.Name, credentials.promptUserForCredential)
+					credentials.commandFinished(err)
+					gui.handleCredentialsPopup(err)
+
+					return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{BRANCHES, REMOTES}})
+				})
+			},
+		})
 	})
 }
 
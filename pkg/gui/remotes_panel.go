@@ -177,7 +177,9 @@ func (gui *Gui) handleFetchRemote() error {
 		gui.Mutexes.FetchMutex.Lock()
 		defer gui.Mutexes.FetchMutex.Unlock()
 
-		err := gui.GitCommand.FetchRemote(remote.Name, gui.promptUserForCredential)
+		credentials := gui.newCredentialSession(true)
+		err := gui.GitCommand.FetchRemote(remote.Name, credentials.promptUserForCredential)
+		credentials.commandFinished(err)
 		gui.handleCredentialsPopup(err)
 
 		return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{BRANCHES, REMOTES}})
@@ -8,6 +8,12 @@ import (
 )
 
 func (gui *Gui) resetToRef(ref string, strength string, span string, options oscommands.RunCommandOptions) error {
+	if strength == "hard" {
+		if err := gui.takeSafetySnapshot(span); err != nil {
+			return gui.surfaceError(err)
+		}
+	}
+
 	if err := gui.GitCommand.WithSpan(span).ResetToCommit(ref, strength, options); err != nil {
 		return gui.surfaceError(err)
 	}
@@ -41,7 +47,13 @@ func (gui *Gui) createResetMenu(ref string) error {
 				),
 			},
 			onPress: func() error {
-				return gui.resetToRef(ref, strength, "Reset", oscommands.RunCommandOptions{})
+				reset := func() error {
+					return gui.resetToRef(ref, strength, "Reset", oscommands.RunCommandOptions{})
+				}
+				if strength == "hard" {
+					return gui.withCheckedOutBranchProtection(HARD_RESET, reset)
+				}
+				return reset()
 			},
 		}
 	}
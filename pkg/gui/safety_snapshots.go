@@ -0,0 +1,73 @@
+package gui
+
+import (
+	"github.com/fatih/color"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// takeSafetySnapshot is to be called before any action that permanently
+// destroys uncommitted work. It does nothing unless the git.safetySnapshots
+// config is on.
+func (gui *Gui) takeSafetySnapshot(name string) error {
+	if !gui.Config.GetUserConfig().Git.SafetySnapshots {
+		return nil
+	}
+
+	return gui.GitCommand.WithSpan(gui.Tr.Spans.CreateSafetySnapshot).CreateSafetySnapshot(name)
+}
+
+func (gui *Gui) handleViewSafetySnapshots() error {
+	snapshots, err := gui.GitCommand.GetSafetySnapshots()
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	if len(snapshots) == 0 {
+		return gui.createErrorPanel(gui.Tr.NoSafetySnapshots)
+	}
+
+	menuItems := make([]*menuItem, len(snapshots))
+	for i, snapshot := range snapshots {
+		snapshot := snapshot
+		menuItems[i] = &menuItem{
+			displayStrings: []string{
+				utils.UnixToDate(snapshot.Time),
+				color.New(color.FgYellow).Sprint(snapshot.Name),
+			},
+			onPress: func() error {
+				return gui.restoreSafetySnapshot(snapshot)
+			},
+		}
+	}
+
+	return gui.createMenu(gui.Tr.SafetySnapshotsTitle, menuItems, createMenuOptions{showCancel: true})
+}
+
+func (gui *Gui) restoreSafetySnapshot(snapshot *commands.SafetySnapshot) error {
+	prompt := utils.ResolvePlaceholderString(
+		gui.Tr.RestoreSafetySnapshotPrompt,
+		map[string]string{
+			"date": utils.UnixToDate(snapshot.Time),
+			"name": snapshot.Name,
+		},
+	)
+
+	return gui.ask(askOpts{
+		title:  gui.Tr.RestoreSafetySnapshotTitle,
+		prompt: prompt,
+		handleConfirm: func() error {
+			// restoring overwrites whatever's in the working tree now, so that
+			// deserves a snapshot of its own
+			if err := gui.takeSafetySnapshot(gui.Tr.Spans.RestoreSafetySnapshot); err != nil {
+				return gui.surfaceError(err)
+			}
+
+			if err := gui.GitCommand.WithSpan(gui.Tr.Spans.RestoreSafetySnapshot).RestoreSafetySnapshot(snapshot); err != nil {
+				return gui.surfaceError(err)
+			}
+
+			return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
+		},
+	})
+}
@@ -0,0 +1,140 @@
+package gui
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Splitting a commit works by stopping a rebase at the commit and undoing it,
+// after which the user stages and commits its changes a piece at a time as they
+// normally would. Once there's nothing left to commit, we continue the rebase.
+
+func (gui *Gui) handleSplitCommit() error {
+	if ok, err := gui.validateNotInFilterMode(); err != nil || !ok {
+		return err
+	}
+
+	if ok, err := gui.validateNormalWorkingTreeState(); !ok {
+		return err
+	}
+
+	commit := gui.getSelectedLocalCommit()
+	if commit == nil {
+		return nil
+	}
+	index := gui.State.Panels.Commits.SelectedLineIdx
+
+	if commit.IsMerge() {
+		return gui.createErrorPanel(gui.Tr.CantSplitMergeCommit)
+	}
+
+	message, err := gui.GitCommand.GetCommitMessage(commit.Sha)
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	return gui.withCheckedOutBranchProtection(REBASE_BRANCH, func() error {
+		return gui.ask(askOpts{
+			title: gui.Tr.SplitCommitTitle,
+			prompt: utils.ResolvePlaceholderString(
+				gui.Tr.SplitCommitPrompt,
+				map[string]string{"commit": commit.ShortSha()},
+			),
+			handleConfirm: func() error {
+				return gui.WithWaitingStatus(gui.Tr.RebasingStatus, func() error {
+					if err := gui.GitCommand.WithSpan(gui.Tr.Spans.SplitCommit).BeginSplitCommit(gui.State.Commits, index); err != nil {
+						return gui.surfaceError(err)
+					}
+
+					gui.State.Modes.Splitting.Start(commit.Sha, message)
+
+					if err := gui.refreshSidePanels(refreshOptions{mode: SYNC}); err != nil {
+						return err
+					}
+
+					gui.State.Panels.Files.SelectedLineIdx = 0
+					return gui.pushContext(gui.State.Contexts.Files)
+				})
+			},
+		})
+	})
+}
+
+// afterSplitCommit is called whenever we commit while splitting. If that was the
+// last of the split commit's changes, we're done and can continue the rebase
+func (gui *Gui) afterSplitCommit() error {
+	if !gui.State.Modes.Splitting.Active() {
+		return nil
+	}
+
+	gui.State.Modes.Splitting.IncrementCommitCount()
+
+	// untracked files can't have come from the commit we're splitting (its
+	// added files are marked as intent-to-add) so we leave them be
+	for _, file := range gui.GitCommand.GetStatusFiles(commands.GetStatusFileOptions{}) {
+		if file.Tracked {
+			return nil
+		}
+	}
+
+	gui.State.Modes.Splitting.Reset()
+
+	return gui.genericMergeCommand("continue")
+}
+
+// afterSplitCommitWithEditor is afterSplitCommit for when the user commits in
+// their editor, where we can only tell that they went through with it because
+// HEAD has moved on from headShaBefore
+func (gui *Gui) afterSplitCommitWithEditor(headShaBefore string) error {
+	if !gui.State.Modes.Splitting.Active() {
+		return nil
+	}
+
+	headSha, err := gui.GitCommand.GetHeadSha()
+	if err != nil || headSha == headShaBefore {
+		return nil
+	}
+
+	return gui.afterSplitCommit()
+}
+
+// refreshSplittingMode leaves split mode if the rebase is no longer underway,
+// e.g. because the user aborted it
+func (gui *Gui) refreshSplittingMode() {
+	if gui.State.Modes.Splitting.Active() && gui.GitCommand.WorkingTreeState() != commands.REBASE_MODE_REBASING {
+		gui.State.Modes.Splitting.Reset()
+	}
+}
+
+func (gui *Gui) abortSplitCommit() error {
+	return gui.ask(askOpts{
+		title:  gui.Tr.AbortSplitCommitTitle,
+		prompt: gui.Tr.AbortSplitCommitPrompt,
+		handleConfirm: func() error {
+			gui.State.Modes.Splitting.Reset()
+
+			return gui.genericMergeCommand("abort")
+		},
+	})
+}
+
+// prefillSplitCommitMessage starts each piece of a split commit off with the
+// original commit's message
+func (gui *Gui) prefillSplitCommitMessage() {
+	message := gui.State.Modes.Splitting.GetMessage()
+	view := gui.Views.CommitMessage
+
+	gui.g.Update(func(*gocui.Gui) error {
+		if err := gui.renderStringSync(view, message); err != nil {
+			return err
+		}
+
+		lines := utils.SplitLines(message)
+		if len(lines) == 0 {
+			return nil
+		}
+
+		return view.SetCursor(len(lines[len(lines)-1]), len(lines)-1)
+	})
+}
@@ -1,6 +1,7 @@
 package gui
 
 import (
+	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -106,9 +107,20 @@ func (gui *Gui) stashDo(method string) error {
 
 		return gui.createErrorPanel(errorMessage)
 	}
+	var journalEntry *commands.UndoJournalEntry
+	if method == "drop" {
+		var err error
+		journalEntry, err = gui.newStashDropJournalEntry(stashEntry)
+		if err != nil {
+			return gui.surfaceError(err)
+		}
+	}
 	if err := gui.GitCommand.WithSpan(gui.Tr.Spans.Stash).StashDo(stashEntry.Index, method); err != nil {
 		return gui.surfaceError(err)
 	}
+	if err := gui.journal(journalEntry); err != nil {
+		return gui.surfaceError(err)
+	}
 	return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{STASH, FILES}})
 }
 
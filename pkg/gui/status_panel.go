@@ -71,10 +71,15 @@ func (gui *Gui) handleStatusClick() error {
 	upstreamStatus := presentation.BranchStatus(currentBranch)
 	repoName := utils.GetCurrentRepoName()
 	switch gui.GitCommand.WorkingTreeState() {
-	case commands.REBASE_MODE_REBASING, commands.REBASE_MODE_MERGING:
+	case commands.REBASE_MODE_REBASING, commands.REBASE_MODE_MERGING, commands.REBASE_MODE_BISECTING:
 		workingTreeStatus := fmt.Sprintf("(%s)", gui.GitCommand.WorkingTreeState())
 		if cursorInSubstring(cx, upstreamStatus+" ", workingTreeStatus) {
-			return gui.handleCreateRebaseOptionsMenu()
+			if gui.GitCommand.WorkingTreeState() == commands.REBASE_MODE_BISECTING {
+				return gui.guardMutating(func() error {
+					return gui.createBisectMenu(gui.State.Modes.Bisecting.GetInfo().Current)
+				})()
+			}
+			return gui.guardMutating(gui.handleCreateRebaseOptionsMenu)()
 		}
 		if cursorInSubstring(cx, upstreamStatus+" "+workingTreeStatus+" ", repoName) {
 			return gui.handleCreateRecentReposMenu()
@@ -24,7 +24,7 @@ func (gui *Gui) handleSubCommitSelect() error {
 		task = NewRenderStringTask("No commits")
 	} else {
 		cmd := gui.OSCommand.ExecutableFromString(
-			gui.GitCommand.ShowCmdStr(commit.Sha, gui.State.Modes.Filtering.GetPath()),
+			gui.GitCommand.ShowCmdStr(commit.Sha, gui.State.Panels.SubCommits.filterPath),
 		)
 
 		task = NewRunPtyTask(cmd)
@@ -76,23 +76,29 @@ func (gui *Gui) handleViewSubCommitFiles() error {
 }
 
 func (gui *Gui) switchToSubCommitsContext(refName string) error {
-	// need to populate my sub commits
-	builder := commands.NewCommitListBuilder(gui.Log, gui.GitCommand, gui.OSCommand, gui.Tr)
-
-	commits, err := builder.GetCommits(
+	return gui.switchToSubCommitsContextWithOpts(
 		commands.GetCommitsOptions{
 			Limit:                gui.State.Panels.Commits.LimitCommits,
 			FilterPath:           gui.State.Modes.Filtering.GetPath(),
 			IncludeRebaseCommits: false,
 			RefName:              refName,
+			TopoOrder:            gui.shouldLoadCommitsForGraph(),
 		},
 	)
+}
+
+func (gui *Gui) switchToSubCommitsContextWithOpts(opts commands.GetCommitsOptions) error {
+	// need to populate my sub commits
+	builder := commands.NewCommitListBuilder(gui.Log, gui.GitCommand, gui.OSCommand, gui.Tr)
+
+	commits, err := builder.GetCommits(opts)
 	if err != nil {
 		return err
 	}
 
 	gui.State.SubCommits = commits
-	gui.State.Panels.SubCommits.refName = refName
+	gui.State.Panels.SubCommits.refName = opts.RefName
+	gui.State.Panels.SubCommits.filterPath = opts.FilterPath
 	gui.State.Panels.SubCommits.SelectedLineIdx = 0
 	gui.State.Contexts.SubCommits.SetParentContext(gui.currentSideListContext())
 
@@ -1,7 +1,11 @@
 package gui
 
 import (
+	"fmt"
+
+	"github.com/fatih/color"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
@@ -15,31 +19,93 @@ func (gui *Gui) getSelectedTag() *models.Tag {
 }
 
 func (gui *Gui) handleCreateTag() error {
+	// leaving commit SHA blank so that we're just creating the tag for the current commit
+	return gui.handleCreateTagMenu("", gui.selectTagAfterCreate)
+}
+
+// handleCreateTagMenu lets the user choose which kind of tag to create.
+// onCreate is called with the new tag's name once it has been created
+func (gui *Gui) handleCreateTagMenu(commitSha string, onCreate func(tagName string) error) error {
+	menuItems := []*menuItem{
+		{
+			displayStrings: []string{gui.Tr.LcLightweightTag, utils.ColoredString("git tag", color.FgYellow)},
+			onPress: func() error {
+				return gui.handleCreateLightweightTag(commitSha, onCreate)
+			},
+		},
+		{
+			displayStrings: []string{gui.Tr.LcAnnotatedTag, utils.ColoredString("git tag -a", color.FgYellow)},
+			onPress: func() error {
+				return gui.handleCreateAnnotatedTag(commitSha, false, onCreate)
+			},
+		},
+		{
+			displayStrings: []string{gui.Tr.LcSignedTag, utils.ColoredString("git tag -s", color.FgYellow)},
+			onPress: func() error {
+				return gui.handleCreateAnnotatedTag(commitSha, true, onCreate)
+			},
+		},
+	}
+
+	return gui.createMenu(gui.Tr.CreateTagMenuTitle, menuItems, createMenuOptions{showCancel: true})
+}
+
+func (gui *Gui) handleCreateLightweightTag(commitSha string, onCreate func(tagName string) error) error {
 	return gui.prompt(promptOpts{
-		title: gui.Tr.CreateTagTitle,
+		title: gui.Tr.TagNameTitle,
 		handleConfirm: func(tagName string) error {
-			// leaving commit SHA blank so that we're just creating the tag for the current commit
-			if err := gui.GitCommand.WithSpan(gui.Tr.Spans.CreateLightweightTag).CreateLightweightTag(tagName, ""); err != nil {
+			if err := gui.GitCommand.WithSpan(gui.Tr.Spans.CreateLightweightTag).CreateLightweightTag(tagName, commitSha); err != nil {
 				return gui.surfaceError(err)
 			}
-			return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{COMMITS, TAGS}, then: func() {
-				// find the index of the tag and set that as the currently selected line
-				for i, tag := range gui.State.Tags {
-					if tag.Name == tagName {
-						gui.State.Panels.Tags.SelectedLineIdx = i
-						if err := gui.State.Contexts.Tags.HandleRender(); err != nil {
-							gui.Log.Error(err)
-						}
-
-						return
+			return onCreate(tagName)
+		},
+	})
+}
+
+func (gui *Gui) handleCreateAnnotatedTag(commitSha string, sign bool, onCreate func(tagName string) error) error {
+	return gui.prompt(promptOpts{
+		title: gui.Tr.TagNameTitle,
+		handleConfirm: func(tagName string) error {
+			return gui.prompt(promptOpts{
+				title: gui.Tr.TagMessageTitle,
+				handleConfirm: func(message string) error {
+					if message == "" {
+						return gui.createErrorPanel(gui.Tr.NoTagMessageError)
 					}
-				}
-			},
+
+					span := gui.Tr.Spans.CreateAnnotatedTag
+					if sign {
+						span = gui.Tr.Spans.CreateSignedTag
+					}
+
+					cmdStr := gui.GitCommand.CreateAnnotatedTagCmdStr(tagName, commitSha, message, sign)
+					gui.OnRunCommand(oscommands.NewCmdLogEntry(cmdStr, span, true))
+					return gui.withGpgHandlingIf(gui.GitCommand.UsingGpgForTag(sign), cmdStr, gui.Tr.CreatingTagStatus, func() error {
+						return onCreate(tagName)
+					})
+				},
 			})
 		},
 	})
 }
 
+func (gui *Gui) selectTagAfterCreate(tagName string) error {
+	return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{COMMITS, TAGS}, then: func() {
+		// find the index of the tag and set that as the currently selected line
+		for i, tag := range gui.State.Tags {
+			if tag.Name == tagName {
+				gui.State.Panels.Tags.SelectedLineIdx = i
+				if err := gui.State.Contexts.Tags.HandleRender(); err != nil {
+					gui.Log.Error(err)
+				}
+
+				return
+			}
+		}
+	},
+	})
+}
+
 // tag-specific handlers
 // view model would need to raise an event called 'tag selected', perhaps containing a tag. The listener would _be_ the main view, or the main context, and it would be able to render to itself.
 func (gui *Gui) handleTagSelect() error {
@@ -51,7 +117,11 @@ func (gui *Gui) handleTagSelect() error {
 		cmd := gui.OSCommand.ExecutableFromString(
 			gui.GitCommand.GetBranchGraphCmdStr(tag.Name),
 		)
-		task = NewRunCommandTask(cmd)
+		if tag.IsAnnotated() {
+			task = NewRunCommandTaskWithPrefix(cmd, gui.tagInfo(tag))
+		} else {
+			task = NewRunCommandTask(cmd)
+		}
 	}
 
 	return gui.refreshMainViews(refreshMainOpts{
@@ -62,6 +132,17 @@ func (gui *Gui) handleTagSelect() error {
 	})
 }
 
+// tagInfo shows the tagger, date and message of an annotated tag above the
+// graph of the commit that it points to
+func (gui *Gui) tagInfo(tag *models.Tag) string {
+	return fmt.Sprintf(
+		"Tagger: %s\nDate:   %s\n\n%s\n\n",
+		utils.ColoredString(tag.Tagger, color.FgYellow),
+		utils.ColoredString(utils.UnixToDate(tag.UnixTimestamp), color.FgBlue),
+		tag.Message,
+	)
+}
+
 // this is a controller: it can't access tags directly. Or can it? It should be able to get but not set. But that's exactly what I'm doing here, setting it. but through a mutator which encapsulates the event.
 func (gui *Gui) refreshTags() error {
 	tags, err := gui.GitCommand.GetTags()
@@ -125,7 +206,9 @@ func (gui *Gui) handlePushTag(tag *models.Tag) error {
 		initialContent: "origin",
 		handleConfirm: func(response string) error {
 			return gui.WithWaitingStatus(gui.Tr.PushingTagStatus, func() error {
-				err := gui.GitCommand.WithSpan(gui.Tr.Spans.PushTag).PushTag(response, tag.Name, gui.promptUserForCredential)
+				credentials := gui.newCredentialSession(true)
+				err := gui.GitCommand.WithSpan(gui.Tr.Spans.PushTag).PushTag(response, tag.Name, credentials.promptUserForCredential)
+				credentials.commandFinished(err)
 				gui.handleCredentialsPopup(err)
 
 				return nil
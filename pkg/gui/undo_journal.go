@@ -0,0 +1,97 @@
+package gui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+)
+
+// Deleting a branch, dropping a stash entry, and discarding changes don't show
+// up in the reflog, so before doing any of those we work out what we'd need to
+// undo them, and once they've succeeded we record that in the undo journal.
+// Undoing then picks whichever is more recent: the latest journal entry or the
+// latest action in the reflog.
+
+func (gui *Gui) newBranchDeleteJournalEntry(branchName string) (*commands.UndoJournalEntry, error) {
+	sha, err := gui.GitCommand.GetBranchTip(branchName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.UndoJournalEntry{
+		Kind: commands.UNDO_JOURNAL_DELETE_BRANCH,
+		Sha:  sha,
+		Name: branchName,
+	}, nil
+}
+
+func (gui *Gui) newStashDropJournalEntry(stashEntry *models.StashEntry) (*commands.UndoJournalEntry, error) {
+	sha, err := gui.GitCommand.GetStashEntrySha(stashEntry.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commands.UndoJournalEntry{
+		Kind: commands.UNDO_JOURNAL_DROP_STASH,
+		Sha:  sha,
+		Name: stashEntry.Name,
+	}, nil
+}
+
+// newDiscardJournalEntry snapshots the changes in the given nodes' files before
+// they're discarded. Untracked files can't be snapshotted so we leave them out.
+func (gui *Gui) newDiscardJournalEntry(nodes ...*filetree.FileNode) (*commands.UndoJournalEntry, error) {
+	paths := []string{}
+	for _, node := range nodes {
+		_ = node.ForEachFile(func(file *models.File) error {
+			if file.ShortStatus != "??" {
+				paths = append(paths, file.Names()...)
+			}
+			return nil
+		})
+	}
+
+	return gui.newDiscardPathsJournalEntry(paths)
+}
+
+// newDiscardPathsJournalEntry returns nil if there's nothing to snapshot
+func (gui *Gui) newDiscardPathsJournalEntry(paths []string) (*commands.UndoJournalEntry, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	sha, err := gui.GitCommand.CreateDiscardSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	if sha == "" {
+		// there are no changes to tracked files to snapshot
+		return nil, nil
+	}
+
+	return &commands.UndoJournalEntry{
+		Kind:  commands.UNDO_JOURNAL_DISCARD,
+		Sha:   sha,
+		Paths: paths,
+	}, nil
+}
+
+// journal records an action that has just succeeded, given the entry we made
+// for it beforehand. The entry may be nil if there was nothing to record.
+func (gui *Gui) journal(entry *commands.UndoJournalEntry) error {
+	if entry == nil {
+		return nil
+	}
+
+	return gui.GitCommand.AddUndoJournalEntry(entry)
+}
+
+func (gui *Gui) undoJournalEntry(entry *commands.UndoJournalEntry) error {
+	return gui.WithWaitingStatus(gui.Tr.UndoingStatus, func() error {
+		if err := gui.GitCommand.WithSpan(gui.Tr.Spans.Undo).UndoJournalEntry(entry); err != nil {
+			return gui.surfaceError(err)
+		}
+
+		return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{BRANCHES, STASH, FILES}})
+	})
+}
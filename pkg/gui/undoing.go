@@ -15,6 +15,17 @@ import (
 // actions we can skip. E.g. if I do do three things, A, B, and C, and hit undo twice,
 // the reflog will read UUCBA, and when I read the first two undos, I know to skip the following
 // two user actions, meaning we end up undoing reflog entry C. Redoing works in a similar way.
+//
+// Mid-rebase, the edits we make to the rebase's TODO file don't show up in the
+// reflog, so we snapshot the TODO file before each edit and undo/redo those edits
+// by restoring the snapshots. Once the rebase has moved on from where an edit was
+// made, we fall back to the reflog, and undoing the rebase itself means aborting it.
+//
+// Some destructive actions, like deleting a branch, don't show up in the reflog
+// at all, so we keep our own journal of those (see undo_journal.go). When
+// undoing, if the latest journal entry is more recent than the reflog action
+// we'd otherwise undo, we undo the journal entry instead. Journal entries
+// can't be redone.
 
 type ReflogActionKind int
 
@@ -29,18 +40,31 @@ type reflogAction struct {
 	kind ReflogActionKind
 	from string
 	to   string
+	// unix timestamp of when the action happened, for comparing against the
+	// undo journal
+	time int64
+}
+
+type rebaseTodoSnapshot struct {
+	todo    string
+	headSha string
+}
+
+type rebaseTodoHistory struct {
+	undoStack []rebaseTodoSnapshot
+	redoStack []rebaseTodoSnapshot
 }
 
 // Here we're going through the reflog and maintaining a counter that represents how many
 // undos/redos/user actions we've seen. when we hit a user action we call the callback specifying
 // what the counter is up to and the nature of the action.
-// If we find ourselves mid-rebase, we just return because undo/redo mid rebase
-// requires knowledge of previous TODO file states, which you can't just get from the reflog.
-// Though we might support this later, hence the use of the CURRENT_REBASE action kind.
+// If we find ourselves mid-rebase, we stop at the start of the rebase with a
+// CURRENT_REBASE action, given that we can't undo past it without aborting it.
 func (gui *Gui) parseReflogForActions(onUserAction func(counter int, action reflogAction) (bool, error)) error {
 	counter := 0
 	reflogCommits := gui.State.FilteredReflogCommits
 	rebaseFinishCommitSha := ""
+	var rebaseFinishTime int64
 	var action *reflogAction
 	for reflogCommitIdx, reflogCommit := range reflogCommits {
 		action = nil
@@ -55,18 +79,19 @@ func (gui *Gui) parseReflogForActions(onUserAction func(counter int, action refl
 				counter++
 			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^\[lazygit redo\]`); ok {
 				counter--
-			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase -i \(abort\)|^rebase -i \(finish\)`); ok {
+			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase( -i)? \((abort|finish)\)`); ok {
 				rebaseFinishCommitSha = reflogCommit.Sha
+				rebaseFinishTime = reflogCommit.UnixTimestamp
 			} else if ok, match := utils.FindStringSubmatch(reflogCommit.Name, `^checkout: moving from ([\S]+) to ([\S]+)`); ok {
-				action = &reflogAction{kind: CHECKOUT, from: match[1], to: match[2]}
+				action = &reflogAction{kind: CHECKOUT, from: match[1], to: match[2], time: reflogCommit.UnixTimestamp}
 			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^commit|^reset: moving to|^pull`); ok {
-				action = &reflogAction{kind: COMMIT, from: prevCommitSha, to: reflogCommit.Sha}
-			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase -i \(start\)`); ok {
+				action = &reflogAction{kind: COMMIT, from: prevCommitSha, to: reflogCommit.Sha, time: reflogCommit.UnixTimestamp}
+			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase( -i)? \(start\)`); ok {
 				// if we're here then we must be currently inside an interactive rebase
-				action = &reflogAction{kind: CURRENT_REBASE, from: prevCommitSha}
+				action = &reflogAction{kind: CURRENT_REBASE, from: prevCommitSha, time: reflogCommit.UnixTimestamp}
 			}
-		} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase -i \(start\)`); ok {
-			action = &reflogAction{kind: REBASE, from: prevCommitSha, to: rebaseFinishCommitSha}
+		} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase( -i)? \(start\)`); ok {
+			action = &reflogAction{kind: REBASE, from: prevCommitSha, to: rebaseFinishCommitSha, time: rebaseFinishTime}
 			rebaseFinishCommitSha = ""
 		}
 
@@ -89,17 +114,31 @@ func (gui *Gui) reflogUndo() error {
 	undoEnvVars := []string{"GIT_REFLOG_ACTION=[lazygit undo]"}
 	undoingStatus := gui.Tr.UndoingStatus
 
+	span := gui.Tr.Spans.Undo
+
 	if gui.GitCommand.WorkingTreeState() == commands.REBASE_MODE_REBASING {
-		return gui.createErrorPanel(gui.Tr.LcCantUndoWhileRebasing)
+		if ok, err := gui.undoRebaseTodoEdit(); ok {
+			return err
+		}
 	}
 
-	span := gui.Tr.Spans.Undo
+	journalEntry, err := gui.GitCommand.GetLatestUndoJournalEntry()
+	if err != nil {
+		return gui.surfaceError(err)
+	}
 
-	return gui.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
+	handled := false
+	err = gui.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
 		if counter != 0 {
 			return false, nil
 		}
 
+		handled = true
+
+		if journalEntry != nil && journalEntry.Time >= action.time {
+			return true, gui.undoJournalEntry(journalEntry)
+		}
+
 		switch action.kind {
 		case COMMIT, REBASE:
 			return true, gui.handleHardResetWithAutoStash(action.from, handleHardResetWithAutoStashOptions{
@@ -113,23 +152,39 @@ func (gui *Gui) reflogUndo() error {
 				WaitingStatus: undoingStatus,
 				span:          span,
 			})
+		case CURRENT_REBASE:
+			return true, gui.ask(askOpts{
+				title:  gui.Tr.UndoRebaseTitle,
+				prompt: gui.Tr.UndoRebasePrompt,
+				handleConfirm: func() error {
+					return gui.genericMergeCommand("abort")
+				},
+			})
 		}
 
 		gui.Log.Error("didn't match on the user action when trying to undo")
 		return true, nil
 	})
+
+	if !handled && journalEntry != nil {
+		return gui.undoJournalEntry(journalEntry)
+	}
+
+	return err
 }
 
 func (gui *Gui) reflogRedo() error {
 	redoEnvVars := []string{"GIT_REFLOG_ACTION=[lazygit redo]"}
 	redoingStatus := gui.Tr.RedoingStatus
 
+	span := gui.Tr.Spans.Redo
+
 	if gui.GitCommand.WorkingTreeState() == commands.REBASE_MODE_REBASING {
-		return gui.createErrorPanel(gui.Tr.LcCantRedoWhileRebasing)
+		if ok, err := gui.redoRebaseTodoEdit(); ok {
+			return err
+		}
 	}
 
-	span := gui.Tr.Spans.Redo
-
 	return gui.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
 		// if we're redoing and the counter is zero, we just return
 		if counter == 0 {
@@ -151,6 +206,9 @@ func (gui *Gui) reflogRedo() error {
 				WaitingStatus: redoingStatus,
 				span:          span,
 			})
+		case CURRENT_REBASE:
+			// the rebase itself hasn't been undone, so there's nothing to redo
+			return true, nil
 		}
 
 		gui.Log.Error("didn't match on the user action when trying to redo")
@@ -158,6 +216,82 @@ func (gui *Gui) reflogRedo() error {
 	})
 }
 
+// snapshotRebaseTodo is to be called before each edit we make to the rebase's
+// TODO file, so that the edit can be undone
+func (gui *Gui) snapshotRebaseTodo() error {
+	snapshot, err := gui.currentRebaseTodoSnapshot()
+	if err != nil {
+		return err
+	}
+
+	history := &gui.State.RebaseTodoHistory
+	history.undoStack = append(history.undoStack, snapshot)
+	history.redoStack = nil
+
+	return nil
+}
+
+func (gui *Gui) currentRebaseTodoSnapshot() (rebaseTodoSnapshot, error) {
+	todo, err := gui.GitCommand.GetRebaseTodo()
+	if err != nil {
+		return rebaseTodoSnapshot{}, err
+	}
+
+	headSha, err := gui.GitCommand.GetHeadSha()
+	if err != nil {
+		return rebaseTodoSnapshot{}, err
+	}
+
+	return rebaseTodoSnapshot{todo: todo, headSha: headSha}, nil
+}
+
+func (gui *Gui) undoRebaseTodoEdit() (bool, error) {
+	history := &gui.State.RebaseTodoHistory
+	return gui.restoreRebaseTodoSnapshot(&history.undoStack, &history.redoStack, gui.Tr.Spans.Undo)
+}
+
+func (gui *Gui) redoRebaseTodoEdit() (bool, error) {
+	history := &gui.State.RebaseTodoHistory
+	return gui.restoreRebaseTodoSnapshot(&history.redoStack, &history.undoStack, gui.Tr.Spans.Redo)
+}
+
+// restoreRebaseTodoSnapshot restores the most recent snapshot from one stack,
+// pushing the current state onto the other. It returns false if there's no
+// snapshot to restore because the rebase has moved on since it was taken.
+func (gui *Gui) restoreRebaseTodoSnapshot(from *[]rebaseTodoSnapshot, to *[]rebaseTodoSnapshot, span string) (bool, error) {
+	if len(*from) == 0 {
+		return false, nil
+	}
+
+	current, err := gui.currentRebaseTodoSnapshot()
+	if err != nil {
+		return true, gui.surfaceError(err)
+	}
+
+	snapshot := (*from)[len(*from)-1]
+	if snapshot.headSha != current.headSha {
+		return false, nil
+	}
+
+	gui.OnRunCommand(oscommands.NewCmdLogEntry("Restoring rebase TODO", span, false))
+
+	if err := gui.GitCommand.SetRebaseTodo(snapshot.todo); err != nil {
+		return true, gui.surfaceError(err)
+	}
+	*from = (*from)[:len(*from)-1]
+	*to = append(*to, current)
+
+	return true, gui.refreshRebaseCommits()
+}
+
+// refreshRebaseTodoHistory forgets the snapshots of the rebase's TODO file once
+// the rebase is no longer underway
+func (gui *Gui) refreshRebaseTodoHistory() {
+	if gui.GitCommand.WorkingTreeState() != commands.REBASE_MODE_REBASING {
+		gui.State.RebaseTodoHistory = rebaseTodoHistory{}
+	}
+}
+
 type handleHardResetWithAutoStashOptions struct {
 	WaitingStatus string
 	EnvVars       []string
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/spkg/bom"
 )
@@ -28,6 +29,7 @@ const (
 	REMOTES
 	STATUS
 	SUBMODULES
+	WORKTREES
 )
 
 func getScopeNames(scopes []RefreshableView) []string {
@@ -36,6 +38,7 @@ func getScopeNames(scopes []RefreshableView) []string {
 		BRANCHES:   "branches",
 		FILES:      "files",
 		SUBMODULES: "submodules",
+		WORKTREES:  "worktrees",
 		STASH:      "stash",
 		REFLOG:     "reflog",
 		TAGS:       "tags",
@@ -105,7 +108,7 @@ func (gui *Gui) refreshSidePanels(options refreshOptions) error {
 	f := func() {
 		var scopeMap map[RefreshableView]bool
 		if len(options.scope) == 0 {
-			scopeMap = arrToMap([]RefreshableView{COMMITS, BRANCHES, FILES, STASH, REFLOG, TAGS, REMOTES, STATUS})
+			scopeMap = arrToMap([]RefreshableView{COMMITS, BRANCHES, FILES, STASH, REFLOG, TAGS, REMOTES, WORKTREES, STATUS})
 		} else {
 			scopeMap = arrToMap(options.scope)
 		}
@@ -170,6 +173,18 @@ func (gui *Gui) refreshSidePanels(options refreshOptions) error {
 			}()
 		}
 
+		if scopeMap[WORKTREES] {
+			wg.Add(1)
+			func() {
+				if options.mode == ASYNC {
+					go utils.Safe(func() { _ = gui.refreshWorktrees() })
+				} else {
+					_ = gui.refreshWorktrees()
+				}
+				wg.Done()
+			}()
+		}
+
 		wg.Wait()
 
 		gui.refreshStatus()
@@ -309,15 +324,33 @@ func (gui *Gui) refreshSelectedLine(panelState IListPanelState, total int) {
 	}
 }
 
-func (gui *Gui) renderDisplayStrings(v *gocui.View, displayStrings [][]string) {
+func (gui *Gui) renderDisplayStrings(v *gocui.View, displayStrings [][]string, panelState IListPanelState) {
 	gui.g.Update(func(g *gocui.Gui) error {
 		list := utils.RenderDisplayStrings(displayStrings)
+		if panelState.IsRangeSelect() {
+			list = highlightRange(list, panelState)
+		}
 		v.Clear()
 		fmt.Fprint(v, list)
 		return nil
 	})
 }
 
+// highlightRange gives every line in the selected range the range background
+// colour. The view itself only highlights the line under the cursor.
+func highlightRange(list string, panelState IListPanelState) string {
+	lines := strings.Split(list, "\n")
+	start, end := panelState.GetSelectedLineRange()
+	for i := start; i <= end && i < len(lines); i++ {
+		if i < 0 {
+			continue
+		}
+		lines[i] = utils.ColoredString(utils.Decolorise(lines[i]), theme.SelectedRangeBgColor)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func (gui *Gui) globalOptionsMap() map[string]string {
 	keybindingConfig := gui.Config.GetUserConfig().Keybinding
 
@@ -21,9 +21,19 @@ func (gui *Gui) handleCreateResetMenu() error {
 				red.Sprint(nukeStr),
 			},
 			onPress: func() error {
+				journalEntry, err := gui.newDiscardPathsJournalEntry([]string{"."})
+				if err != nil {
+					return gui.surfaceError(err)
+				}
+				if err := gui.takeSafetySnapshot(gui.Tr.Spans.NukeWorkingTree); err != nil {
+					return gui.surfaceError(err)
+				}
 				if err := gui.GitCommand.WithSpan(gui.Tr.Spans.NukeWorkingTree).ResetAndClean(); err != nil {
 					return gui.surfaceError(err)
 				}
+				if err := gui.journal(journalEntry); err != nil {
+					return gui.surfaceError(err)
+				}
 
 				return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
 			},
@@ -34,9 +44,16 @@ func (gui *Gui) handleCreateResetMenu() error {
 				red.Sprint("git checkout -- ."),
 			},
 			onPress: func() error {
+				journalEntry, err := gui.newDiscardPathsJournalEntry([]string{"."})
+				if err != nil {
+					return gui.surfaceError(err)
+				}
 				if err := gui.GitCommand.WithSpan(gui.Tr.Spans.DiscardUnstagedFileChanges).DiscardAnyUnstagedFileChanges(); err != nil {
 					return gui.surfaceError(err)
 				}
+				if err := gui.journal(journalEntry); err != nil {
+					return gui.surfaceError(err)
+				}
 
 				return gui.refreshSidePanels(refreshOptions{mode: ASYNC, scope: []RefreshableView{FILES}})
 			},
@@ -47,6 +64,9 @@ func (gui *Gui) handleCreateResetMenu() error {
 				red.Sprint("git clean -fd"),
 			},
 			onPress: func() error {
+				if err := gui.takeSafetySnapshot(gui.Tr.Spans.RemoveUntrackedFiles); err != nil {
+					return gui.surfaceError(err)
+				}
 				if err := gui.GitCommand.WithSpan(gui.Tr.Spans.RemoveUntrackedFiles).RemoveUntrackedFiles(); err != nil {
 					return gui.surfaceError(err)
 				}
@@ -86,6 +106,9 @@ func (gui *Gui) handleCreateResetMenu() error {
 				red.Sprint("git reset --hard HEAD"),
 			},
 			onPress: func() error {
+				if err := gui.takeSafetySnapshot(gui.Tr.Spans.HardReset); err != nil {
+					return gui.surfaceError(err)
+				}
 				if err := gui.GitCommand.WithSpan(gui.Tr.Spans.HardReset).ResetHard("HEAD"); err != nil {
 					return gui.surfaceError(err)
 				}
@@ -0,0 +1,198 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+func (gui *Gui) getSelectedWorktree() *models.Worktree {
+	selectedLine := gui.State.Panels.Worktrees.SelectedLineIdx
+	if selectedLine == -1 || len(gui.State.Worktrees) == 0 {
+		return nil
+	}
+
+	return gui.State.Worktrees[selectedLine]
+}
+
+func (gui *Gui) handleWorktreeSelect() error {
+	var task updateTask
+	worktree := gui.getSelectedWorktree()
+	if worktree == nil {
+		task = NewRenderStringTask(gui.Tr.NoWorktrees)
+	} else {
+		branch := worktree.Branch
+		if branch == "" {
+			branch = "(detached)"
+		}
+
+		prefix := fmt.Sprintf(
+			"Name:   %s\nPath:   %s\nBranch: %s\nHEAD:   %s\n\n",
+			utils.ColoredString(worktree.Name(), color.FgGreen),
+			utils.ColoredString(worktree.Path, color.FgYellow),
+			utils.ColoredString(branch, color.FgCyan),
+			utils.ColoredString(worktree.Head, color.FgBlue),
+		)
+
+		ref := worktree.Branch
+		if ref == "" {
+			ref = worktree.Head
+		}
+
+		if ref == "" || worktree.Prunable {
+			task = NewRenderStringTask(prefix)
+		} else {
+			cmd := gui.OSCommand.ExecutableFromString(gui.GitCommand.GetBranchGraphCmdStr(ref))
+			task = NewRunCommandTaskWithPrefix(cmd, prefix)
+		}
+	}
+
+	return gui.refreshMainViews(refreshMainOpts{
+		main: &viewUpdateOpts{
+			title: "Worktree",
+			task:  task,
+		},
+	})
+}
+
+func (gui *Gui) refreshWorktrees() error {
+	worktrees, err := gui.GitCommand.GetWorktrees()
+	if err != nil {
+		return gui.surfaceError(err)
+	}
+
+	gui.State.Worktrees = worktrees
+
+	return gui.postRefreshUpdate(gui.State.Contexts.Worktrees)
+}
+
+func (gui *Gui) forWorktree(callback func(*models.Worktree) error) func() error {
+	return func() error {
+		worktree := gui.getSelectedWorktree()
+		if worktree == nil {
+			return nil
+		}
+
+		return callback(worktree)
+	}
+}
+
+// switching worktrees goes through the same path as entering a submodule, so
+// that the gui state of each worktree is retained in our RepoStateMap. Unlike
+// submodules we don't push onto the RepoPathStack because worktrees are
+// siblings rather than children of one another.
+func (gui *Gui) handleWorktreeEnter(worktree *models.Worktree) error {
+	if worktree.IsCurrent {
+		return gui.createErrorPanel(gui.Tr.AlreadyInWorktree)
+	}
+
+	if worktree.Bare {
+		return gui.createErrorPanel(gui.Tr.CantSwitchToBareWorktree)
+	}
+
+	return gui.dispatchSwitchToRepo(worktree.Path, true)
+}
+
+func (gui *Gui) handleAddWorktree() error {
+	return gui.prompt(promptOpts{
+		title: gui.Tr.LcNewWorktreePath,
+		handleConfirm: func(path string) error {
+			return gui.prompt(promptOpts{
+				title:               gui.Tr.LcNewWorktreeBranch,
+				findSuggestionsFunc: gui.findBranchNameSuggestions,
+				handleConfirm: func(branchName string) error {
+					branchName = sanitizedBranchName(branchName)
+					createBranch := branchName != "" && !gui.branchExists(branchName)
+
+					return gui.WithWaitingStatus(gui.Tr.LcAddingWorktreeStatus, func() error {
+						if err := gui.GitCommand.WithSpan(gui.Tr.Spans.AddWorktree).WorktreeAdd(path, branchName, createBranch); err != nil {
+							return gui.surfaceError(err)
+						}
+
+						return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{WORKTREES, BRANCHES}})
+					})
+				},
+			})
+		},
+	})
+}
+
+func (gui *Gui) branchExists(branchName string) bool {
+	for _, branch := range gui.State.Branches {
+		if branch.Name == branchName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (gui *Gui) handleRemoveWorktreeMenu(worktree *models.Worktree) error {
+	menuItems := []*menuItem{
+		{
+			displayStrings: []string{gui.Tr.LcRemoveWorktree, utils.ColoredString(fmt.Sprintf("git worktree remove %s", worktree.Path), color.FgRed)},
+			onPress: func() error {
+				return gui.removeWorktree(worktree, false)
+			},
+		},
+		{
+			displayStrings: []string{gui.Tr.LcForceRemoveWorktree, utils.ColoredString(fmt.Sprintf("git worktree remove --force %s", worktree.Path), color.FgRed)},
+			onPress: func() error {
+				return gui.removeWorktree(worktree, true)
+			},
+		},
+		{
+			displayStrings: []string{gui.Tr.LcPruneWorktrees, utils.ColoredString("git worktree prune", color.FgYellow)},
+			onPress: func() error {
+				return gui.WithWaitingStatus(gui.Tr.LcRunningCommand, func() error {
+					if err := gui.GitCommand.WithSpan(gui.Tr.Spans.PruneWorktrees).WorktreePrune(); err != nil {
+						return gui.surfaceError(err)
+					}
+
+					return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{WORKTREES}})
+				})
+			},
+		},
+	}
+
+	return gui.createMenu(worktree.Name(), menuItems, createMenuOptions{showCancel: true})
+}
+
+func (gui *Gui) removeWorktree(worktree *models.Worktree, force bool) error {
+	if worktree.IsMain {
+		return gui.createErrorPanel(gui.Tr.CantRemoveMainWorktree)
+	}
+
+	if worktree.IsCurrent {
+		return gui.createErrorPanel(gui.Tr.CantRemoveCurrentWorktree)
+	}
+
+	promptTemplate := gui.Tr.RemoveWorktreePrompt
+	if force {
+		promptTemplate = gui.Tr.ForceRemoveWorktreePrompt
+	}
+
+	prompt := utils.ResolvePlaceholderString(
+		promptTemplate,
+		map[string]string{
+			"worktreeName": worktree.Name(),
+			"worktreePath": worktree.Path,
+		},
+	)
+
+	return gui.ask(askOpts{
+		title:  gui.Tr.RemoveWorktree,
+		prompt: prompt,
+		handleConfirm: func() error {
+			return gui.WithWaitingStatus(gui.Tr.LcRunningCommand, func() error {
+				if err := gui.GitCommand.WithSpan(gui.Tr.Spans.RemoveWorktree).WorktreeRemove(worktree.Path, force); err != nil {
+					return gui.surfaceError(err)
+				}
+
+				return gui.refreshSidePanels(refreshOptions{scope: []RefreshableView{WORKTREES}})
+			})
+		},
+	})
+}
@@ -36,8 +36,6 @@ func chineseTranslationSet() TranslationSet {
 		NormalTitle:                         "正常",
 		CommitMessage:                       "提交信息",
 		CredentialsUsername:                 "用户名",
-		CredentialsPassword:                 "密码",
-		CredentialsPassphrase:               "输入SSH密钥的密码",
 		PassUnameWrong:                      "密码, 密码 和/或 用户名错误",
 		CommitChanges:                       "提交更改",
 		AmendLastCommit:                     "修改最后一次提交",
@@ -247,8 +245,8 @@ func chineseTranslationSet() TranslationSet {
 		NextHunk:                            "选择下一个块",
 		PrevConflict:                        "选择上一个冲突",
 		NextConflict:                        "选择下一个冲突",
-		SelectTop:                           "选择顶部块",
-		SelectBottom:                        "选择底部块",
+		SelectPrevHunk:                      "选择上一个块",
+		SelectNextHunk:                      "选择下一个块",
 		ScrollDown:                          "向下滚动",
 		ScrollUp:                            "向上滚动",
 		LcScrollUpMainPanel:                 "向上滚动主面板",
@@ -344,7 +342,6 @@ func chineseTranslationSet() TranslationSet {
 		PushTagTitle:                        "远程将标签'{{.tagName}}'推送到:",
 		LcPushTag:                           "推送标签",
 		LcCreateTag:                         "创建标签",
-		CreateTagTitle:                      "标签名:",
 		LcFetchRemote:                       "获取远程",
 		FetchingRemoteStatus:                "获取远程",
 		LcCheckoutCommit:                    "签出提交",
@@ -368,8 +365,6 @@ func chineseTranslationSet() TranslationSet {
 		LcResetCherryPick:                   "重置 cherry-picked(复制)提交选择",
 		LcNextTab:                           "下一个标签",
 		LcPrevTab:                           "上一个标签",
-		LcCantUndoWhileRebasing:             "进行基础调整时无法撤消",
-		LcCantRedoWhileRebasing:             "变基时无法重做",
 		MustStashWarning:                    "将补丁拉出到索引中需要存储和取消存储所做的更改。如果出现问题，您将可以从存储中访问文件。继续？",
 		MustStashTitle:                      "必须stash",
 		ConfirmationTitle:                   "确认面板",
@@ -18,8 +18,6 @@ func dutchTranslationSet() TranslationSet {
 		NormalTitle:                         "Normaal",
 		CommitMessage:                       "Commitbericht",
 		CredentialsUsername:                 "Gebruikersnaam",
-		CredentialsPassword:                 "Wachtwoord",
-		CredentialsPassphrase:               "Voer een wachtwoordzin in voor de SSH-sleutel",
 		PassUnameWrong:                      "Wachtwoord en/of gebruikersnaam verkeerd",
 		CommitChanges:                       "Commit veranderingen",
 		AmendLastCommit:                     "wijzig laatste commit",
@@ -225,8 +223,8 @@ func dutchTranslationSet() TranslationSet {
 		NextHunk:                            "selecteer de volgende hunk",
 		PrevConflict:                        "selecteer voorgaand conflict",
 		NextConflict:                        "selecteer volgende conflict",
-		SelectTop:                           "selecteer bovenste hunk",
-		SelectBottom:                        "selecteer onderste hunk",
+		SelectPrevHunk:                      "selecteer vorige hunk",
+		SelectNextHunk:                      "selecteer volgende hunk",
 		ScrollDown:                          "scroll omlaag",
 		ScrollUp:                            "scroll omhoog",
 		LcScrollUpMainPanel:                 "scroll naar beneden vanaf hoofdpaneel",
@@ -321,7 +319,6 @@ func dutchTranslationSet() TranslationSet {
 		PushTagTitle:                        "remote om tag '{{.tagName}}' te pushen naar:",
 		LcPushTag:                           "push tag",
 		LcCreateTag:                         "creëer tag",
-		CreateTagTitle:                      "Tag naam:",
 		LcFetchRemote:                       "fetch remote",
 		FetchingRemoteStatus:                "remote fetchen",
 		LcCheckoutCommit:                    "checkout commit",
@@ -345,8 +342,6 @@ func dutchTranslationSet() TranslationSet {
 		LcResetCherryPick:                   "reset cherry-picked (gekopieerde) commits selectie",
 		LcNextTab:                           "volgende tabblad",
 		LcPrevTab:                           "vorige tabblad",
-		LcCantUndoWhileRebasing:             "Kan niet ongedaan maken terwijl je aan het rebasen bent",
-		LcCantRedoWhileRebasing:             "Kan niet opnieuw doen (redo) terwijl je aan het rebasen bent",
 		MustStashWarning:                    "Een patch in de index stoppen vereist stashen en onstashen van je wijzigingen. Als er iets verkeert gaat kan je je bestanden terug vinden in de stash. Verder gaan?",
 		MustStashTitle:                      "Moet stashen",
 		ConfirmationTitle:                   "Bevestigingspaneel",
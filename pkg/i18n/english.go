@@ -28,8 +28,6 @@ type TranslationSet struct {
 	NormalTitle                         string
 	CommitMessage                       string
 	CredentialsUsername                 string
-	CredentialsPassword                 string
-	CredentialsPassphrase               string
 	PassUnameWrong                      string
 	CommitChanges                       string
 	AmendLastCommit                     string
@@ -239,8 +237,8 @@ type TranslationSet struct {
 	NextHunk                            string
 	PrevConflict                        string
 	NextConflict                        string
-	SelectTop                           string
-	SelectBottom                        string
+	SelectPrevHunk                      string
+	SelectNextHunk                      string
 	ScrollDown                          string
 	ScrollUp                            string
 	LcScrollUpMainPanel                 string
@@ -336,7 +334,6 @@ type TranslationSet struct {
 	PushTagTitle                        string
 	LcPushTag                           string
 	LcCreateTag                         string
-	CreateTagTitle                      string
 	LcFetchRemote                       string
 	FetchingRemoteStatus                string
 	LcCheckoutCommit                    string
@@ -360,8 +357,6 @@ type TranslationSet struct {
 	LcResetCherryPick                   string
 	LcNextTab                           string
 	LcPrevTab                           string
-	LcCantUndoWhileRebasing             string
-	LcCantRedoWhileRebasing             string
 	MustStashWarning                    string
 	MustStashTitle                      string
 	ConfirmationTitle                   string
@@ -454,6 +449,110 @@ type TranslationSet struct {
 	ToggleWhitespaceInDiffView          string
 	IgnoringWhitespaceInDiffView        string
 	ShowingWhitespaceInDiffView         string
+	WorktreesTitle                      string
+	LcSwitchToWorktree                  string
+	LcCopyWorktreePathToClipboard       string
+	LcAddWorktree                       string
+	LcNewWorktreePath                   string
+	LcNewWorktreeBranch                 string
+	LcAddingWorktreeStatus              string
+	LcRemoveWorktree                    string
+	LcForceRemoveWorktree               string
+	LcPruneWorktrees                    string
+	LcViewWorktreeOptions               string
+	RemoveWorktree                      string
+	RemoveWorktreePrompt                string
+	ForceRemoveWorktreePrompt           string
+	AlreadyInWorktree                   string
+	CantSwitchToBareWorktree            string
+	CantRemoveMainWorktree              string
+	CantRemoveCurrentWorktree           string
+	NoWorktrees                         string
+	BisectMenuTitle                     string
+	LcViewBisectOptions                 string
+	LcBisectMark                        string
+	LcResetBisect                       string
+	LcBisectingStatus                   string
+	LcBisecting                         string
+	ResetBisectTitle                    string
+	ResetBisectPrompt                   string
+	BisectCompleteTitle                 string
+	BisectComplete                      string
+	BisectCompleteWithSkips             string
+	CreateTagMenuTitle                  string
+	LcLightweightTag                    string
+	LcAnnotatedTag                      string
+	LcSignedTag                         string
+	TagMessageTitle                     string
+	NoTagMessageError                   string
+	CreatingTagStatus                   string
+	BlameTitle                          string
+	BlameLineNotCommitted               string
+	BlameCommitNotFound                 string
+	NoEarlierBlame                      string
+	LcBlameFile                         string
+	LcBlameGoToCommit                   string
+	LcBlameParent                       string
+	LcBlameStepForward                  string
+	LcExitBlame                         string
+	LcViewLineRangeHistory              string
+	LineRangeHasNoHistory               string
+	LcToggleRangeSelect                 string
+	ErrStageRangeConflicts              string
+	ErrDiscardRangeWithSubmodule        string
+	DiscardRangeTitle                   string
+	SureSquashTheseCommits              string
+	SureFixupTheseCommits               string
+	DeleteCommitsPrompt                 string
+	RangeSpansRebaseTodo                string
+	LcSplitCommit                       string
+	SplitCommitTitle                    string
+	SplitCommitPrompt                   string
+	CantSplitMergeCommit                string
+	LcSplittingCommit                   string
+	LcSplitCommitHint                   string
+	AbortSplitCommitTitle               string
+	AbortSplitCommitPrompt              string
+	LcAbsorb                            string
+	AbsorbTitle                         string
+	AbsorbPrompt                        string
+	NothingToAbsorb                     string
+	CommitNotCreated                    string
+	CantChangeTodoCommandAction         string
+	TodoCommandHasNoCommit              string
+	LcInsertExecTodo                    string
+	LcInsertBreakTodo                   string
+	InsertExecTodoTitle                 string
+	NotInInteractiveRebase              string
+	CantInsertTodoBelowCurrentCommit    string
+	LcRebaseOnto                        string
+	RebaseOntoTitle                     string
+	ConfirmRebaseOnto                   string
+	CantRebaseOntoUpstream              string
+	NoCommitsToRebaseOnto               string
+	LcSelectNewBaseForRebaseOnto        string
+	UndoRebaseTitle                     string
+	UndoRebasePrompt                    string
+	LcViewSafetySnapshots               string
+	SafetySnapshotsTitle                string
+	NoSafetySnapshots                   string
+	RestoreSafetySnapshotTitle          string
+	RestoreSafetySnapshotPrompt         string
+	LcForcePush                         string
+	LcRebase                            string
+	ProtectedBranchBlocked              string
+	ProtectedBranchConfirmTitle         string
+	ProtectedBranchConfirmMismatch      string
+	ForcePushOverwritesCommitsPrompt    string
+	AndMoreCommits                      string
+	ReadOnlyModeError                   string
+	LcOpenCommitInBrowser               string
+	LcOpenFileInBrowser                 string
+	LcOpenLinesInBrowser                string
+	LcOpenTagInBrowser                  string
+	LcForgetCredentials                 string
+	CredentialsForgotten                string
+	HostKeyPromptTitle                  string
 	Spans                               Spans
 }
 
@@ -536,6 +635,23 @@ type Spans struct {
 	HardReset                         string
 	Undo                              string
 	Redo                              string
+	AddWorktree                       string
+	RemoveWorktree                    string
+	PruneWorktrees                    string
+	StartBisect                       string
+	BisectMark                        string
+	ResetBisect                       string
+	CreateAnnotatedTag                string
+	CreateSignedTag                   string
+	DiscardAllChangesInRange          string
+	DiscardUnstagedChangesInRange     string
+	SplitCommit                       string
+	Absorb                            string
+	InsertRebaseTodo                  string
+	RebaseOnto                        string
+	CreateSafetySnapshot              string
+	RestoreSafetySnapshot             string
+	OpenInBrowser                     string
 }
 
 const englishIntroPopupMessage = `
@@ -574,8 +690,6 @@ func englishTranslationSet() TranslationSet {
 		NormalTitle:                         "Normal",
 		CommitMessage:                       "Commit message",
 		CredentialsUsername:                 "Username",
-		CredentialsPassword:                 "Password",
-		CredentialsPassphrase:               "Enter passphrase for SSH key",
 		PassUnameWrong:                      "Password, passphrase and/or username wrong",
 		CommitChanges:                       "commit changes",
 		AmendLastCommit:                     "amend last commit",
@@ -785,8 +899,8 @@ func englishTranslationSet() TranslationSet {
 		NextHunk:                            "select next hunk",
 		PrevConflict:                        "select previous conflict",
 		NextConflict:                        "select next conflict",
-		SelectTop:                           "select top hunk",
-		SelectBottom:                        "select bottom hunk",
+		SelectPrevHunk:                      "select previous hunk",
+		SelectNextHunk:                      "select next hunk",
 		ScrollDown:                          "scroll down",
 		ScrollUp:                            "scroll up",
 		LcScrollUpMainPanel:                 "scroll up main panel",
@@ -882,7 +996,6 @@ func englishTranslationSet() TranslationSet {
 		PushTagTitle:                        "remote to push tag '{{.tagName}}' to:",
 		LcPushTag:                           "push tag",
 		LcCreateTag:                         "create tag",
-		CreateTagTitle:                      "Tag name:",
 		LcFetchRemote:                       "fetch remote",
 		FetchingRemoteStatus:                "fetching remote",
 		LcCheckoutCommit:                    "checkout commit",
@@ -906,8 +1019,6 @@ func englishTranslationSet() TranslationSet {
 		LcResetCherryPick:                   "reset cherry-picked (copied) commits selection",
 		LcNextTab:                           "next tab",
 		LcPrevTab:                           "previous tab",
-		LcCantUndoWhileRebasing:             "Can't undo while rebasing",
-		LcCantRedoWhileRebasing:             "Can't redo while rebasing",
 		MustStashWarning:                    "Pulling a patch out into the index requires stashing and unstashing your changes. If something goes wrong, you'll be able to access your files from the stash. Continue?",
 		MustStashTitle:                      "Must stash",
 		ConfirmationTitle:                   "Confirmation Panel",
@@ -1001,6 +1112,122 @@ func englishTranslationSet() TranslationSet {
 		ToggleWhitespaceInDiffView:          "Toggle whether or not whitespace changes are shown in the diff view",
 		IgnoringWhitespaceInDiffView:        "Whitespace will be ignored in the diff view",
 		ShowingWhitespaceInDiffView:         "Whitespace will be shown in the diff view",
+		WorktreesTitle:                      "Worktrees",
+		LcSwitchToWorktree:                  "switch to worktree",
+		LcCopyWorktreePathToClipboard:       "copy worktree path to clipboard",
+		LcAddWorktree:                       "add new worktree",
+		LcNewWorktreePath:                   "new worktree path:",
+		LcNewWorktreeBranch:                 "branch to check out (leave blank to create one named after the path):",
+		LcAddingWorktreeStatus:              "adding worktree",
+		LcRemoveWorktree:                    "remove worktree",
+		LcForceRemoveWorktree:               "force remove worktree (discarding uncommitted changes)",
+		LcPruneWorktrees:                    "prune stale worktrees",
+		LcViewWorktreeOptions:               "view remove/prune options",
+		RemoveWorktree:                      "Remove worktree",
+		RemoveWorktreePrompt:                "Are you sure you want to remove worktree '{{.worktreeName}}' at '{{.worktreePath}}'?",
+		ForceRemoveWorktreePrompt:           "Are you sure you want to force remove worktree '{{.worktreeName}}' at '{{.worktreePath}}'? Any uncommitted changes in it will be lost.",
+		AlreadyInWorktree:                   "You are already in this worktree",
+		CantSwitchToBareWorktree:            "Cannot switch to a bare repository",
+		CantRemoveMainWorktree:              "Cannot remove the main worktree",
+		CantRemoveCurrentWorktree:           "Cannot remove the worktree you are currently in. Switch to another worktree first",
+		NoWorktrees:                         "No worktrees",
+		BisectMenuTitle:                     "Bisect",
+		LcViewBisectOptions:                 "view bisect options",
+		LcBisectMark:                        "mark {{.ref}} as {{.term}}",
+		LcResetBisect:                       "reset bisect",
+		LcBisectingStatus:                   "bisecting",
+		LcBisecting:                         "bisecting",
+		ResetBisectTitle:                    "Reset bisect",
+		ResetBisectPrompt:                   "Are you sure you want to reset 'git bisect'? This will check out the commit you were on when you started bisecting",
+		BisectCompleteTitle:                 "Bisect complete",
+		BisectComplete: `The first bad commit is:
+{{.commit}}
+
+Do you want to reset 'git bisect' now?`,
+		BisectCompleteWithSkips: `Because some commits were skipped, git cannot narrow it down any further. The first bad commit is one of:
+{{.commits}}
+
+Do you want to reset 'git bisect' now?`,
+		CreateTagMenuTitle:               "Create tag",
+		LcLightweightTag:                 "lightweight tag",
+		LcAnnotatedTag:                   "annotated tag",
+		LcSignedTag:                      "GPG-signed tag",
+		TagMessageTitle:                  "Tag message:",
+		NoTagMessageError:                "Annotated tags require a message",
+		CreatingTagStatus:                "creating tag",
+		BlameTitle:                       "Blame",
+		BlameLineNotCommitted:            "This line has not been committed yet",
+		BlameCommitNotFound:              "Could not find this commit in the current branch's history",
+		NoEarlierBlame:                   "There is no earlier version of this line to blame",
+		LcBlameFile:                      "blame file",
+		LcBlameGoToCommit:                "go to commit in commits panel",
+		LcBlameParent:                    "blame file as it was before this line's commit",
+		LcBlameStepForward:               "undo stepping back to an earlier version",
+		LcExitBlame:                      "exit blame",
+		LcViewLineRangeHistory:           "view history of lines {{firstLine}}-{{lastLine}} of '{{path}}'",
+		LineRangeHasNoHistory:            "The selected lines were added or removed by these changes, so there's no history to show for them",
+		LcToggleRangeSelect:              "toggle range select",
+		ErrStageRangeConflicts:           "Cannot stage/unstage a range containing files with inline merge conflicts. Please fix up the merge conflicts first",
+		ErrDiscardRangeWithSubmodule:     "Cannot discard a range containing a submodule. Please select the submodule on its own",
+		DiscardRangeTitle:                "{{count}} selected items",
+		SureSquashTheseCommits:           "Are you sure you want to squash the selected commits into the commit below?",
+		SureFixupTheseCommits:            "Are you sure you want to 'fixup' the selected commits? They will be merged into the commit below",
+		DeleteCommitsPrompt:              "Are you sure you want to delete the selected commits?",
+		RangeSpansRebaseTodo:             "The selected range must be either entirely within the rebase TODO list or entirely outside of it",
+		LcSplitCommit:                    "split commit into several commits",
+		SplitCommitTitle:                 "Split commit",
+		SplitCommitPrompt:                "This will start a rebase at {{commit}} and undo the commit, leaving its changes unstaged. Stage and commit them in as many pieces as you like, and once everything is committed the rebase will continue. Continue?",
+		CantSplitMergeCommit:             "Cannot split a merge commit",
+		LcSplittingCommit:                "splitting {{commit}} ({{count}} commits so far)",
+		LcSplitCommitHint:                "stage and commit the remaining changes to finish",
+		AbortSplitCommitTitle:            "Abort split",
+		AbortSplitCommitPrompt:           "Are you sure you want to abort splitting the commit? This will abort the rebase, restoring the original commit",
+		LcAbsorb:                         "absorb staged changes into the commits they fix",
+		AbsorbTitle:                      "Absorb staged changes",
+		AbsorbPrompt:                     "Are you sure you want to absorb your staged changes? Each staged hunk will be squashed into the most recent unpushed commit on this branch that touched its lines. Hunks that don't belong to any such commit are left uncommitted.",
+		NothingToAbsorb:                  "None of your staged changes touch lines from an unpushed commit on this branch that can be absorbed into",
+		CommitNotCreated:                 "The commit wasn't created",
+		CantChangeTodoCommandAction:      "Only rebase todos which pick a commit can have their action changed",
+		TodoCommandHasNoCommit:           "This rebase todo doesn't refer to a commit",
+		LcInsertExecTodo:                 "insert exec line into rebase todo",
+		LcInsertBreakTodo:                "insert break line into rebase todo",
+		InsertExecTodoTitle:              "Command to exec",
+		NotInInteractiveRebase:           "You can only do this during an interactive rebase",
+		CantInsertTodoBelowCurrentCommit: "Lines can only be inserted above the commit the rebase is currently at",
+		LcRebaseOnto:                     "rebase onto: mark upstream, then pick new base",
+		RebaseOntoTitle:                  "Rebase onto",
+		ConfirmRebaseOnto: `Are you sure you want to rebase the commits after '{{.upstream}}' onto '{{.newBase}}'? These commits will be moved:
+
+{{.commits}}`,
+		CantRebaseOntoUpstream:         "The new base must differ from the upstream",
+		NoCommitsToRebaseOnto:          "There are no commits after the upstream to rebase",
+		LcSelectNewBaseForRebaseOnto:   "rebasing commits after {{.upstream}}: press the rebase onto key on the new base",
+		UndoRebaseTitle:                "Undo rebase",
+		UndoRebasePrompt:               "There are no more edits to undo in this rebase. Do you want to abort the rebase, returning the branch to where it was before?",
+		LcViewSafetySnapshots:          "view safety snapshots",
+		SafetySnapshotsTitle:           "Safety snapshots",
+		NoSafetySnapshots:              "No safety snapshots. Set git.safetySnapshots in your config to take one before each hard reset, discard, or clean",
+		RestoreSafetySnapshotTitle:     "Restore safety snapshot",
+		RestoreSafetySnapshotPrompt:    "Are you sure you want to restore the index and working tree to how they were before \"{{.name}}\" on {{.date}}? This will overwrite any changes you have now.",
+		LcForcePush:                    "force push",
+		LcRebase:                       "rebase",
+		ProtectedBranchBlocked:         "You can't {{.action}} '{{.branch}}' because it's a protected branch",
+		ProtectedBranchConfirmTitle:    "'{{.branch}}' is protected: type its name to {{.action}} it",
+		ProtectedBranchConfirmMismatch: "That doesn't match '{{.branch}}', so the {{.action}} was cancelled",
+		ForcePushOverwritesCommitsPrompt: `Your branch has diverged from the remote branch. Force pushing will throw away these commits on the remote:
+
+{{.commits}}
+
+Press 'esc' to cancel, or 'enter' to force push.`,
+		AndMoreCommits:        "...and {{.count}} more",
+		ReadOnlyModeError:     "lazygit is in read-only mode, so anything that would change the repo is disabled. Restart lazygit without the --readonly flag (and with readOnly: false in your config) to do this.",
+		LcOpenCommitInBrowser: "open commit in browser",
+		LcOpenFileInBrowser:   "open file in browser",
+		LcOpenLinesInBrowser:  "open selected lines in browser",
+		LcOpenTagInBrowser:    "open tag in browser",
+		LcForgetCredentials:   "forget cached credentials",
+		CredentialsForgotten:  "Forgot cached credentials",
+		HostKeyPromptTitle:    "Unknown host",
 		Spans: Spans{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
 			CheckoutCommit:                    "Checkout commit",
@@ -1081,6 +1308,23 @@ func englishTranslationSet() TranslationSet {
 			FastForwardBranch:                 "Fast forward branch",
 			Undo:                              "Undo",
 			Redo:                              "Redo",
+			AddWorktree:                       "Add worktree",
+			RemoveWorktree:                    "Remove worktree",
+			PruneWorktrees:                    "Prune worktrees",
+			StartBisect:                       "Start bisect",
+			BisectMark:                        "Bisect mark",
+			ResetBisect:                       "Reset bisect",
+			CreateAnnotatedTag:                "Create annotated tag",
+			CreateSignedTag:                   "Create signed tag",
+			DiscardAllChangesInRange:          "Discard all changes in selected files",
+			DiscardUnstagedChangesInRange:     "Discard unstaged changes in selected files",
+			SplitCommit:                       "Split commit",
+			Absorb:                            "Absorb staged changes",
+			InsertRebaseTodo:                  "Insert rebase todo",
+			RebaseOnto:                        "Rebase onto",
+			CreateSafetySnapshot:              "Create safety snapshot",
+			RestoreSafetySnapshot:             "Restore safety snapshot",
+			OpenInBrowser:                     "Open in browser",
 		},
 	}
 }
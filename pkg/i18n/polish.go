@@ -14,8 +14,6 @@ func polishTranslationSet() TranslationSet {
 		MergingMainTitle:                    "Resolve merge conflicts",
 		CommitMessage:                       "Wiadomość commita",
 		CredentialsUsername:                 "Username",
-		CredentialsPassword:                 "Password",
-		CredentialsPassphrase:               "Passphrase",
 		PassUnameWrong:                      "Password, passphrase and/or username wrong",
 		CommitChanges:                       "commituj zmiany",
 		AmendLastCommit:                     "zmień ostatnie zatwierdzenie",
@@ -186,8 +184,6 @@ func polishTranslationSet() TranslationSet {
 		NextHunk:                            "select next hunk",
 		PrevConflict:                        "select previous conflict",
 		NextConflict:                        "select next conflict",
-		SelectTop:                           "select top hunk",
-		SelectBottom:                        "select bottom hunk",
 		ScrollDown:                          "scroll down",
 		ScrollUp:                            "scroll up",
 		AmendCommitTitle:                    "Amend Commit",
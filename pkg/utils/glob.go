@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MatchGlob reports whether name matches the glob pattern. As with path.Match,
+// '*' and '?' don't match a '/', but '**' matches any number of characters
+// including slashes, so that e.g. 'release/**' matches 'release/1.0/hotfix'.
+// A malformed pattern matches nothing.
+func MatchGlob(pattern string, name string) bool {
+	rgx, err := regexp.Compile(globToRegexp(pattern))
+	if err != nil {
+		return false
+	}
+
+	return rgx.MatchString(name)
+}
+
+func globToRegexp(pattern string) string {
+	var result strings.Builder
+	result.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch char := pattern[i]; char {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**/") {
+				// '**/' also matches nothing at all, so that 'a/**/b' matches 'a/b'
+				result.WriteString("(.*/)?")
+				i += 2
+			} else if strings.HasPrefix(pattern[i:], "**") {
+				result.WriteString(".*")
+				i++
+			} else {
+				result.WriteString("[^/]*")
+			}
+		case '?':
+			result.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end == -1 {
+				result.WriteString(regexp.QuoteMeta("["))
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			result.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			result.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			result.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+
+	result.WriteString("$")
+	return result.String()
+}
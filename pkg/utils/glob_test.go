@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMatchGlob is a function.
+func TestMatchGlob(t *testing.T) {
+	type scenario struct {
+		pattern  string
+		name     string
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{"main", "main", true},
+		{"main", "main2", false},
+		{"*", "main", true},
+		{"*", "feature/foo", false},
+		{"release/*", "release/1.0", true},
+		{"release/*", "release/1.0/hotfix", false},
+		{"release/**", "release/1.0/hotfix", true},
+		{"**", "a/b/c", true},
+		{"**", "main", true},
+		{"a/**/c", "a/c", true},
+		{"a/**/c", "a/b/b/c", true},
+		{"a/**/c", "a/b/d", false},
+		{"v?.0", "v1.0", true},
+		{"v?.0", "v10.0", false},
+		{"v[0-9].x", "v3.x", true},
+		{"v[!0-9].x", "v3.x", false},
+		{"hotfix-\\*", "hotfix-*", true},
+		{"hotfix-\\*", "hotfix-1", false},
+		{"feature.x", "featureAx", false},
+		{"[unclosed", "[unclosed", true},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, MatchGlob(s.pattern, s.name), s.pattern+" "+s.name)
+	}
+}
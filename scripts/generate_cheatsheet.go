@@ -27,7 +27,7 @@ type bindingSection struct {
 
 func main() {
 	langs := []string{"pl", "nl", "en"}
-	mConfig, _ := config.NewAppConfig("", "", "", "", "", true)
+	mConfig, _ := config.NewAppConfig("", "", "", "", "", true, false)
 
 	for _, lang := range langs {
 		os.Setenv("LC_ALL", lang)
@@ -61,6 +61,7 @@ func localisedTitle(mApp *app.App, str string) string {
 		"files":          tr.FilesTitle,
 		"status":         tr.StatusTitle,
 		"submodules":     tr.SubmodulesTitle,
+		"worktrees":      tr.WorktreesTitle,
 		"subCommits":     tr.SubCommitsTitle,
 		"remoteBranches": tr.RemoteBranchesTitle,
 		"remotes":        tr.RemotesTitle,
@@ -75,6 +76,7 @@ func localisedTitle(mApp *app.App, str string) string {
 		"main":           tr.MainTitle,
 		"patchBuilding":  tr.PatchBuildingTitle,
 		"merging":        tr.MergingTitle,
+		"blame":          tr.BlameTitle,
 		"normal":         tr.NormalTitle,
 		"staging":        tr.StagingTitle,
 		"menu":           tr.MenuTitle,